@@ -21,12 +21,31 @@ type RateLimit = client.RateLimit
 // WSConnOptions is a convenience wrapper around client.WSConnOptions.
 type WSConnOptions = client.WSConnOptions
 
+// WSConnLimits is a convenience wrapper around client.WSConnLimits.
+type WSConnLimits = client.WSConnLimits
+
 // ReconnectPolicy is a convenience wrapper around client.ReconnectPolicy.
 type ReconnectPolicy = client.ReconnectPolicy
 
 // BackoffPolicy is a convenience wrapper around client.BackoffPolicy.
 type BackoffPolicy = client.BackoffPolicy
 
+// ReconnectBudget is a convenience wrapper around client.ReconnectBudget.
+type ReconnectBudget = client.ReconnectBudget
+
+// JitterStrategy is a convenience wrapper around client.JitterStrategy.
+type JitterStrategy = client.JitterStrategy
+
+const (
+	JitterNone         = client.JitterNone
+	JitterFull         = client.JitterFull
+	JitterEqual        = client.JitterEqual
+	JitterDecorrelated = client.JitterDecorrelated
+)
+
+// StaleStreamPolicy is a convenience wrapper around client.StaleStreamPolicy.
+type StaleStreamPolicy = client.StaleStreamPolicy
+
 // ServiceBaseResponse is a convenience wrapper around services.ServiceBaseResponse.
 type ServiceBaseResponse = services.ServiceBaseResponse
 
@@ -30,7 +30,7 @@ func NewClient(apiKey string, secretKey string, opts *ClientOptions) *Client {
 	c.th = newTimeHandler(c)
 	c.rlm = newRateLimitManager(opts.RateLimits, c.th, c.logger)
 	c.rc = newRestClient(c.th, c.rlm, apiConfig, c.logger)
-	c.wc = newWSClient(c.th, opts.WSConnOpts, opts.WSDefaultReconnectPolicy, c.logger)
+	c.wc = newWSClient(c.th, opts.WSConnOpts, opts.WSConnLimits, opts.WSDefaultReconnectPolicy, opts.WSReconnectPolicies, opts.WSStaleStreamPolicy, c.logger)
 
 	return c
 }
@@ -61,6 +61,10 @@ func (c *Client) NewSpotUserDataStream() *streams.SpotUserDataStream {
 	return streams.NewSpotUserDataStream(c.wc, c.logger)
 }
 
+func (c *Client) NewSpotMarginDiffDepthStream() *streams.SpotMarginDiffDepthStream {
+	return streams.NewSpotMarginDiffDepthStream(c.wc, c.logger)
+}
+
 func (c *Client) NewSpotMarginDiffDepth100Stream() *streams.SpotMarginDiffDepthStream {
 	return streams.NewSpotMarginDiffDepth100Stream(c.wc, c.logger)
 }
@@ -69,14 +73,74 @@ func (c *Client) NewSpotMarginAggTradesStream() *streams.SpotMarginAggTradesStre
 	return streams.NewSpotMarginAggTradesStream(c.wc, c.logger)
 }
 
+func (c *Client) NewSpotMarginTradeStream() *streams.SpotMarginTradeStream {
+	return streams.NewSpotMarginTradeStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginKlineStream() *streams.SpotMarginKlineStream {
+	return streams.NewSpotMarginKlineStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginBookTickerStream() *streams.SpotMarginBookTickerStream {
+	return streams.NewSpotMarginBookTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginTickerStream() *streams.SpotMarginTickerStream {
+	return streams.NewSpotMarginTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginMiniTickerStream() *streams.SpotMarginMiniTickerStream {
+	return streams.NewSpotMarginMiniTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginAllTickersStream() *streams.SpotMarginAllTickersStream {
+	return streams.NewSpotMarginAllTickersStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginAllMiniTickersStream() *streams.SpotMarginAllMiniTickersStream {
+	return streams.NewSpotMarginAllMiniTickersStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginRollingWindowTickerStream() *streams.SpotMarginRollingWindowTickerStream {
+	return streams.NewSpotMarginRollingWindowTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginPartialDepthStream() *streams.SpotMarginPartialDepthStream {
+	return streams.NewSpotMarginPartialDepthStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotUserDataCallbackStream() *streams.SpotUserDataCallbackStream {
+	return streams.NewSpotUserDataCallbackStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginDiffDepthCallbackStream() *streams.SpotMarginDiffDepthCallbackStream {
+	return streams.NewSpotMarginDiffDepthCallbackStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginDiffDepth100CallbackStream() *streams.SpotMarginDiffDepthCallbackStream {
+	return streams.NewSpotMarginDiffDepth100CallbackStream(c.wc, c.logger)
+}
+
+func (c *Client) NewSpotMarginAggTradesCallbackStream() *streams.SpotMarginAggTradesCallbackStream {
+	return streams.NewSpotMarginAggTradesCallbackStream(c.wc, c.logger)
+}
+
 /* ==================== SAPI-Streams Factory ============================= */
 
 func (c *Client) NewMarginUserDataStream() *streams.MarginUserDataStream {
 	return streams.NewMarginUserDataStream(c.wc, c.logger)
 }
 
+func (c *Client) NewMarginUserDataCallbackStream() *streams.MarginUserDataCallbackStream {
+	return streams.NewMarginUserDataCallbackStream(c.wc, c.logger)
+}
+
 /* ==================== FAPI-Streams Factory ============================= */
 
+func (c *Client) NewFuturesDiffDepthStream() *streams.FuturesDiffDepthStream {
+	return streams.NewFuturesDiffDepthStream(c.wc, c.logger)
+}
+
 func (c *Client) NewFuturesDiffDepth100Stream() *streams.FuturesDiffDepthStream {
 	return streams.NewFuturesDiffDepth100Stream(c.wc, c.logger)
 }
@@ -85,6 +149,86 @@ func (c *Client) NewFuturesAggTradesStream() *streams.FuturesAggTradesStream {
 	return streams.NewFuturesAggTradesStream(c.wc, c.logger)
 }
 
+func (c *Client) NewFuturesTradeStream() *streams.FuturesTradeStream {
+	return streams.NewFuturesTradeStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesKlineStream() *streams.FuturesKlineStream {
+	return streams.NewFuturesKlineStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesContinuousKlineStream() *streams.FuturesContinuousKlineStream {
+	return streams.NewFuturesContinuousKlineStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesBookTickerStream() *streams.FuturesBookTickerStream {
+	return streams.NewFuturesBookTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesAllBookTickerStream() *streams.FuturesBookTickerStream {
+	return streams.NewFuturesAllBookTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesTickerStream() *streams.FuturesTickerStream {
+	return streams.NewFuturesTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesMiniTickerStream() *streams.FuturesMiniTickerStream {
+	return streams.NewFuturesMiniTickerStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesAllTickersStream() *streams.FuturesAllTickersStream {
+	return streams.NewFuturesAllTickersStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesAllMiniTickersStream() *streams.FuturesAllMiniTickersStream {
+	return streams.NewFuturesAllMiniTickersStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesPartialDepthStream() *streams.FuturesPartialDepthStream {
+	return streams.NewFuturesPartialDepthStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesMarkPriceStream() *streams.FuturesMarkPriceStream {
+	return streams.NewFuturesMarkPriceStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesAllMarkPriceStream() *streams.FuturesAllMarkPriceStream {
+	return streams.NewFuturesAllMarkPriceStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesForceOrderStream() *streams.FuturesForceOrderStream {
+	return streams.NewFuturesForceOrderStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesAllForceOrderStream() *streams.FuturesForceOrderStream {
+	return streams.NewFuturesAllForceOrderStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesCompositeIndexStream() *streams.FuturesCompositeIndexStream {
+	return streams.NewFuturesCompositeIndexStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesContractInfoStream() *streams.FuturesContractInfoStream {
+	return streams.NewFuturesContractInfoStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesUserDataStream() *streams.FuturesUserDataStream {
+	return streams.NewFuturesUserDataStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesDiffDepthCallbackStream() *streams.FuturesDiffDepthCallbackStream {
+	return streams.NewFuturesDiffDepthCallbackStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesDiffDepth100CallbackStream() *streams.FuturesDiffDepthCallbackStream {
+	return streams.NewFuturesDiffDepth100CallbackStream(c.wc, c.logger)
+}
+
+func (c *Client) NewFuturesAggTradesCallbackStream() *streams.FuturesAggTradesCallbackStream {
+	return streams.NewFuturesAggTradesCallbackStream(c.wc, c.logger)
+}
+
 /* ==================== API-Services Factory ============================= */
 
 func (c *Client) NewSpotMarginPingService() *services.PingService {
@@ -119,6 +263,134 @@ func (c *Client) NewSpotMarginExchangeInfoService() *services.SpotMarginExchange
 	return services.NewSpotMarginExchangeInfoService(c.rc, c.logger)
 }
 
+func (c *Client) NewCreateSpotOrderService() *services.CreateSpotOrderService {
+	return services.NewCreateSpotOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewTestSpotOrderService() *services.TestSpotOrderService {
+	return services.NewTestSpotOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelSpotOrderService() *services.CancelSpotOrderService {
+	return services.NewCancelSpotOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelAllSpotOrdersService() *services.CancelAllSpotOrdersService {
+	return services.NewCancelAllSpotOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewQuerySpotOrderService() *services.QuerySpotOrderService {
+	return services.NewQuerySpotOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewOpenSpotOrdersService() *services.OpenSpotOrdersService {
+	return services.NewOpenSpotOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelReplaceSpotOrderService() *services.CancelReplaceSpotOrderService {
+	return services.NewCancelReplaceSpotOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewAmendSpotOrderService() *services.AmendSpotOrderService {
+	return services.NewAmendSpotOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateSpotOCOOrderListService() *services.CreateSpotOCOOrderListService {
+	return services.NewCreateSpotOCOOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateSpotOTOOrderListService() *services.CreateSpotOTOOrderListService {
+	return services.NewCreateSpotOTOOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateSpotOTOCOOrderListService() *services.CreateSpotOTOCOOrderListService {
+	return services.NewCreateSpotOTOCOOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelSpotOrderListService() *services.CancelOrderListService {
+	return services.NewCancelSpotOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewQuerySpotOrderListService() *services.QueryOrderListService {
+	return services.NewQuerySpotOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewOpenSpotOrderListsService() *services.OpenOrderListsService {
+	return services.NewOpenSpotOrderListsService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotAccountService() *services.SpotAccountService {
+	return services.NewSpotAccountService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotTradesService() *services.SpotTradesService {
+	return services.NewSpotTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewAllSpotOrdersService() *services.AllSpotOrdersService {
+	return services.NewAllSpotOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotPreventedMatchesService() *services.SpotPreventedMatchesService {
+	return services.NewSpotPreventedMatchesService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotAllocationsService() *services.SpotAllocationsService {
+	return services.NewSpotAllocationsService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotCommissionService() *services.SpotCommissionService {
+	return services.NewSpotCommissionService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotOrderRateLimitService() *services.SpotOrderRateLimitService {
+	return services.NewSpotOrderRateLimitService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginKlinesService() *services.KlinesService {
+	return services.NewSpotMarginKlinesService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginUIKlinesService() *services.KlinesService {
+	return services.NewSpotMarginUIKlinesService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginRecentTradesService() *services.RecentTradesService {
+	return services.NewSpotMarginRecentTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginHistoricalTradesService() *services.HistoricalTradesService {
+	return services.NewSpotMarginHistoricalTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginAggTradesService() *services.AggTradesService {
+	return services.NewSpotMarginAggTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginAvgPriceService() *services.SpotMarginAvgPriceService {
+	return services.NewSpotMarginAvgPriceService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginTicker24hrService() *services.SpotMarginTicker24hrService {
+	return services.NewSpotMarginTicker24hrService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginRollingWindowTickerService() *services.SpotMarginRollingWindowTickerService {
+	return services.NewSpotMarginRollingWindowTickerService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginTradingDayTickerService() *services.SpotMarginTradingDayTickerService {
+	return services.NewSpotMarginTradingDayTickerService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginPriceTickerService() *services.SpotMarginPriceTickerService {
+	return services.NewSpotMarginPriceTickerService(c.rc, c.logger)
+}
+
+func (c *Client) NewSpotMarginBookTickerService() *services.SpotMarginBookTickerService {
+	return services.NewSpotMarginBookTickerService(c.rc, c.logger)
+}
+
 /* ==================== SAPI-Services Factory ============================ */
 
 func (c *Client) NewMarginSystemStatusService() *services.SystemStatusService {
@@ -137,10 +409,106 @@ func (c *Client) NewMarginCloseListenKeyService() *services.CloseListenKeyServic
 	return services.NewMarginCloseListenKeyService(c.rc, c.logger)
 }
 
+func (c *Client) NewIsolatedMarginCreateListenKeyService() *services.CreateListenKeyService {
+	return services.NewIsolatedMarginCreateListenKeyService(c.rc, c.logger)
+}
+
+func (c *Client) NewIsolatedMarginPingListenKeyService() *services.PingListenKeyService {
+	return services.NewIsolatedMarginPingListenKeyService(c.rc, c.logger)
+}
+
+func (c *Client) NewIsolatedMarginCloseListenKeyService() *services.CloseListenKeyService {
+	return services.NewIsolatedMarginCloseListenKeyService(c.rc, c.logger)
+}
+
 func (c *Client) NewCreateMarginOrderService() *services.CreateMarginOrderService {
 	return services.NewCreateMarginOrderService(c.rc, c.logger)
 }
 
+func (c *Client) NewCreateMarginOCOOrderListService() *services.CreateMarginOCOOrderListService {
+	return services.NewCreateMarginOCOOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateMarginOTOOrderListService() *services.CreateMarginOTOOrderListService {
+	return services.NewCreateMarginOTOOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateMarginOTOCOOrderListService() *services.CreateMarginOTOCOOrderListService {
+	return services.NewCreateMarginOTOCOOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelMarginOrderListService() *services.CancelOrderListService {
+	return services.NewCancelMarginOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewQueryMarginOrderListService() *services.QueryOrderListService {
+	return services.NewQueryMarginOrderListService(c.rc, c.logger)
+}
+
+func (c *Client) NewOpenMarginOrderListsService() *services.OpenOrderListsService {
+	return services.NewOpenMarginOrderListsService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelMarginOrderService() *services.CancelMarginOrderService {
+	return services.NewCancelMarginOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelAllMarginOrdersService() *services.CancelAllMarginOrdersService {
+	return services.NewCancelAllMarginOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewQueryMarginOrderService() *services.QueryMarginOrderService {
+	return services.NewQueryMarginOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewOpenMarginOrdersService() *services.OpenMarginOrdersService {
+	return services.NewOpenMarginOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewAllMarginOrdersService() *services.AllMarginOrdersService {
+	return services.NewAllMarginOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginTradesService() *services.MarginTradesService {
+	return services.NewMarginTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginOrderRateLimitService() *services.MarginOrderRateLimitService {
+	return services.NewMarginOrderRateLimitService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginBorrowRepayService() *services.MarginBorrowRepayService {
+	return services.NewMarginBorrowRepayService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginMaxBorrowableService() *services.MarginMaxBorrowableService {
+	return services.NewMarginMaxBorrowableService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginMaxTransferableService() *services.MarginMaxTransferableService {
+	return services.NewMarginMaxTransferableService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginAccountService() *services.MarginAccountService {
+	return services.NewMarginAccountService(c.rc, c.logger)
+}
+
+func (c *Client) NewIsolatedMarginAccountService() *services.IsolatedMarginAccountService {
+	return services.NewIsolatedMarginAccountService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginInterestHistoryService() *services.MarginInterestHistoryService {
+	return services.NewMarginInterestHistoryService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginTransferService() *services.MarginTransferService {
+	return services.NewMarginTransferService(c.rc, c.logger)
+}
+
+func (c *Client) NewMarginTransferHistoryService() *services.MarginTransferHistoryService {
+	return services.NewMarginTransferHistoryService(c.rc, c.logger)
+}
+
 /* ==================== FAPI-Services Factory ============================ */
 
 func (c *Client) NewFuturesPingService() *services.PingService {
@@ -158,3 +526,167 @@ func (c *Client) NewFuturesDepth1000Service() *services.FuturesDepthService {
 func (c *Client) NewFuturesExchangeInfoService() *services.FuturesExchangeInfoService {
 	return services.NewFuturesExchangeInfoService(c.rc, c.logger)
 }
+
+func (c *Client) NewFuturesCreateListenKeyService() *services.CreateListenKeyService {
+	return services.NewFuturesCreateListenKeyService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesPingListenKeyService() *services.PingListenKeyService {
+	return services.NewFuturesPingListenKeyService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesCloseListenKeyService() *services.CloseListenKeyService {
+	return services.NewFuturesCloseListenKeyService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateFuturesOrderService() *services.CreateFuturesOrderService {
+	return services.NewCreateFuturesOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCreateFuturesBatchOrdersService() *services.CreateFuturesBatchOrdersService {
+	return services.NewCreateFuturesBatchOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewModifyFuturesOrderService() *services.ModifyFuturesOrderService {
+	return services.NewModifyFuturesOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelFuturesOrderService() *services.CancelFuturesOrderService {
+	return services.NewCancelFuturesOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewCancelAllFuturesOrdersService() *services.CancelAllFuturesOrdersService {
+	return services.NewCancelAllFuturesOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewCountdownCancelAllFuturesService() *services.CountdownCancelAllFuturesService {
+	return services.NewCountdownCancelAllFuturesService(c.rc, c.logger)
+}
+
+func (c *Client) NewQueryFuturesOrderService() *services.QueryFuturesOrderService {
+	return services.NewQueryFuturesOrderService(c.rc, c.logger)
+}
+
+func (c *Client) NewOpenFuturesOrdersService() *services.OpenFuturesOrdersService {
+	return services.NewOpenFuturesOrdersService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesAccountV2Service() *services.FuturesAccountService {
+	return services.NewFuturesAccountV2Service(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesAccountV3Service() *services.FuturesAccountService {
+	return services.NewFuturesAccountV3Service(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesBalanceService() *services.FuturesBalanceService {
+	return services.NewFuturesBalanceService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesPositionRiskService() *services.FuturesPositionRiskService {
+	return services.NewFuturesPositionRiskService(c.rc, c.logger)
+}
+
+func (c *Client) NewChangeFuturesLeverageService() *services.ChangeFuturesLeverageService {
+	return services.NewChangeFuturesLeverageService(c.rc, c.logger)
+}
+
+func (c *Client) NewChangeFuturesMarginTypeService() *services.ChangeFuturesMarginTypeService {
+	return services.NewChangeFuturesMarginTypeService(c.rc, c.logger)
+}
+
+func (c *Client) NewModifyFuturesPositionMarginService() *services.ModifyFuturesPositionMarginService {
+	return services.NewModifyFuturesPositionMarginService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesPositionModeService() *services.FuturesPositionModeService {
+	return services.NewFuturesPositionModeService(c.rc, c.logger)
+}
+
+func (c *Client) NewChangeFuturesPositionModeService() *services.ChangeFuturesPositionModeService {
+	return services.NewChangeFuturesPositionModeService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesMultiAssetsModeService() *services.FuturesMultiAssetsModeService {
+	return services.NewFuturesMultiAssetsModeService(c.rc, c.logger)
+}
+
+func (c *Client) NewChangeFuturesMultiAssetsModeService() *services.ChangeFuturesMultiAssetsModeService {
+	return services.NewChangeFuturesMultiAssetsModeService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesLeverageBracketService() *services.FuturesLeverageBracketService {
+	return services.NewFuturesLeverageBracketService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesCommissionRateService() *services.FuturesCommissionRateService {
+	return services.NewFuturesCommissionRateService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesIncomeHistoryService() *services.FuturesIncomeHistoryService {
+	return services.NewFuturesIncomeHistoryService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesTradesService() *services.FuturesTradesService {
+	return services.NewFuturesTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesKlinesService() *services.KlinesService {
+	return services.NewFuturesKlinesService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesRecentTradesService() *services.RecentTradesService {
+	return services.NewFuturesRecentTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesHistoricalTradesService() *services.HistoricalTradesService {
+	return services.NewFuturesHistoricalTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesAggTradesService() *services.AggTradesService {
+	return services.NewFuturesAggTradesService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesTicker24hrService() *services.FuturesTicker24hrService {
+	return services.NewFuturesTicker24hrService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesPriceTickerService() *services.FuturesPriceTickerService {
+	return services.NewFuturesPriceTickerService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesBookTickerService() *services.FuturesBookTickerService {
+	return services.NewFuturesBookTickerService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesPremiumIndexService() *services.FuturesPremiumIndexService {
+	return services.NewFuturesPremiumIndexService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesFundingRateService() *services.FuturesFundingRateService {
+	return services.NewFuturesFundingRateService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesOpenInterestService() *services.FuturesOpenInterestService {
+	return services.NewFuturesOpenInterestService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesOpenInterestHistService() *services.FuturesOpenInterestHistService {
+	return services.NewFuturesOpenInterestHistService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesTopLongShortAccountRatioService() *services.FuturesLongShortRatioService {
+	return services.NewFuturesTopLongShortAccountRatioService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesTopLongShortPositionRatioService() *services.FuturesLongShortRatioService {
+	return services.NewFuturesTopLongShortPositionRatioService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesGlobalLongShortAccountRatioService() *services.FuturesLongShortRatioService {
+	return services.NewFuturesGlobalLongShortAccountRatioService(c.rc, c.logger)
+}
+
+func (c *Client) NewFuturesTakerLongShortRatioService() *services.FuturesTakerLongShortRatioService {
+	return services.NewFuturesTakerLongShortRatioService(c.rc, c.logger)
+}
@@ -11,28 +11,112 @@ import (
 
 /* ==================== ClientOptions ==================================== */
 
+// JitterStrategy determines how the interval between reconnect attempts is
+// randomized. Jitter spreads out the reconnect attempts of many clients that
+// were disconnected at the same time.
+type JitterStrategy int
+
+const (
+	// JitterNone waits for the exponential interval (no randomization).
+	JitterNone JitterStrategy = iota
+	// JitterFull waits for a random duration in [0, interval).
+	JitterFull
+	// JitterEqual waits for interval/2 plus a random duration in [0, interval/2).
+	JitterEqual
+	// JitterDecorrelated waits for a random duration in
+	// [InitialInterval, previous wait * Multiplier), capped at MaxInterval.
+	JitterDecorrelated
+)
+
+// String returns the name of the JitterStrategy.
+func (j JitterStrategy) String() string {
+	switch j {
+	case JitterNone:
+		return "none"
+	case JitterFull:
+		return "full"
+	case JitterEqual:
+		return "equal"
+	case JitterDecorrelated:
+		return "decorrelated"
+	default:
+		return "unknown"
+	}
+}
+
 // BackoffPolicy
+// Fields:
+//   - InitialInterval: the interval before the second attempt.
+//   - MaxInterval: the upper bound for the interval.
+//   - Multiplier: the factor by which the interval grows after each attempt.
+//   - Jitter: the JitterStrategy used to randomize the interval.
 type BackoffPolicy struct {
 	InitialInterval time.Duration
 	MaxInterval     time.Duration
 	Multiplier      float64
+	Jitter          JitterStrategy
+}
+
+// ReconnectBudget limits the number of reconnect attempts of a single stream
+// within a sliding window. Unlike MaxAttempts, which is reset every time a
+// connection is established, the budget persists over the lifetime of the
+// stream. It stops streams that connect and drop again and again from
+// retrying forever. A MaxAttempts of 0 disables the budget.
+type ReconnectBudget struct {
+	MaxAttempts int
+	Interval    time.Duration
 }
 
 // ReconnectPolicy
 // Fields:
 //   - Enabled: whether or not the reconnect policy is enabled.
-//   - MaxAttempts: the max number of attempts to reconnect.
+//   - MaxAttempts: the max number of attempts to reconnect. -1 means retry
+//     until the context is cancelled.
 //   - BackoffPolicy: the backoff policy to use.
 //   - MinConnDuration: the minimum amount of time a connection must be open
 //     to not be considered an early disconnect.
 //   - MaxConsecEarlyDisconnects: the max number of consecutive early
 //     disconnects before the reconnect policy is disabled.
+//   - Budget: the max number of reconnect attempts within a sliding window.
 type ReconnectPolicy struct {
 	Enabled                   bool
 	MaxAttempts               int
 	BackoffPolicy             BackoffPolicy
 	MinConnDuration           time.Duration
 	MaxConsecEarlyDisconnects int
+	Budget                    ReconnectBudget
+}
+
+// StaleStreamPolicy configures the inactivity watchdog of a stream.
+// The watchdog forces a reconnect (a transient common.WSConnError) when no
+// data message is received within the stale timeout. Pings and pongs do not
+// count as data messages.
+// Fields:
+//   - Enabled: whether or not the watchdog is enabled.
+//   - MaxMissedIntervals: the number of update intervals
+//     (StreamDefinition.UpdateSpeed) without data before a stream is stale.
+//   - MinTimeout: the lower bound for the stale timeout.
+//   - RealTimeTimeout: the stale timeout for real-time streams
+//     (UpdateSpeed == 0). If 0, real-time streams are not watched.
+type StaleStreamPolicy struct {
+	Enabled            bool
+	MaxMissedIntervals int
+	MinTimeout         time.Duration
+	RealTimeTimeout    time.Duration
+}
+
+// WSConnLimits configures the websocket limits enforced by the client.
+// A limit of 0 disables it.
+// Fields:
+//   - MaxConnAttempts: the max number of dials per endpoint within
+//     ConnAttemptsInterval (binance: 300 per 5 minutes per IP).
+//   - ConnAttemptsInterval: the sliding window for MaxConnAttempts.
+//   - MaxMessagesPerSecond: the max number of messages (pings, pongs and
+//     JSON control messages) sent per connection per second (binance: 5).
+type WSConnLimits struct {
+	MaxConnAttempts      int
+	ConnAttemptsInterval time.Duration
+	MaxMessagesPerSecond int
 }
 
 // RateLimit
@@ -52,7 +136,10 @@ type ClientOptions struct {
 	LogOutput                io.Writer     // default: os.Stderr
 	RateLimits               []RateLimit   // default: []RateLimit{}
 	WSConnOpts               WSConnOptions
+	WSConnLimits             WSConnLimits
 	WSDefaultReconnectPolicy ReconnectPolicy
+	WSReconnectPolicies      map[common.BIStreamType]ReconnectPolicy // overrides the default per stream type
+	WSStaleStreamPolicy      StaleStreamPolicy
 }
 
 type WSConnOptions struct {
@@ -82,18 +169,64 @@ func DefaultClientOptions() *ClientOptions {
 				RateLimitIntervalNum:  1,
 				Limit:                 6000,
 			},
+			{
+				EndpointType:          common.EndpointTypeAPI,
+				RateLimitType:         common.RateLimitTypeUID,
+				RateLimitIntervalType: common.IntervalSecond,
+				RateLimitIntervalNum:  10,
+				Limit:                 100,
+			},
+			{
+				EndpointType:          common.EndpointTypeAPI,
+				RateLimitType:         common.RateLimitTypeUID,
+				RateLimitIntervalType: common.IntervalDay,
+				RateLimitIntervalNum:  1,
+				Limit:                 200000,
+			},
+			{
+				EndpointType:          common.EndpointTypeFAPI,
+				RateLimitType:         common.RateLimitTypeUID,
+				RateLimitIntervalType: common.IntervalSecond,
+				RateLimitIntervalNum:  10,
+				Limit:                 300,
+			},
+			{
+				EndpointType:          common.EndpointTypeFAPI,
+				RateLimitType:         common.RateLimitTypeUID,
+				RateLimitIntervalType: common.IntervalMinute,
+				RateLimitIntervalNum:  1,
+				Limit:                 1200,
+			},
 		},
 		WSConnOpts: WSConnOptions{
 			WSWriteWait:  3 * time.Second,
 			WSPongWait:   5 * time.Second,
 			WSPingPeriod: (5 * time.Second * 8) / 10,
 		},
+		WSConnLimits: WSConnLimits{
+			MaxConnAttempts:      300,
+			ConnAttemptsInterval: 5 * time.Minute,
+			MaxMessagesPerSecond: 5,
+		},
 		WSDefaultReconnectPolicy: ReconnectPolicy{
-			Enabled:                   false,
-			MaxAttempts:               0,
-			BackoffPolicy:             BackoffPolicy{},
-			MinConnDuration:           0,
-			MaxConsecEarlyDisconnects: 0,
+			Enabled:     true,
+			MaxAttempts: -1,
+			BackoffPolicy: BackoffPolicy{
+				InitialInterval: 1 * time.Second,
+				MaxInterval:     60 * time.Second,
+				Multiplier:      2,
+				Jitter:          JitterFull,
+			},
+			MinConnDuration:           30 * time.Second,
+			MaxConsecEarlyDisconnects: 10,
+			Budget:                    ReconnectBudget{MaxAttempts: 60, Interval: 10 * time.Minute},
+		},
+		WSReconnectPolicies: map[common.BIStreamType]ReconnectPolicy{},
+		WSStaleStreamPolicy: StaleStreamPolicy{
+			Enabled:            false,
+			MaxMissedIntervals: 50,
+			MinTimeout:         10 * time.Second,
+			RealTimeTimeout:    0,
 		},
 	}
 }
@@ -78,3 +78,27 @@ func TestUpdateUsed(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRateLimitsUsedDefaultOrderLimits(t *testing.T) {
+	th := &mockTimeHandler{tsl: 0, offset: 0}
+	logger := log.NewEntry(log.StandardLogger())
+	rc := &restClient{rlm: newRateLimitManager(DefaultClientOptions().RateLimits, th, logger)}
+
+	// order counts (e.g. from an order rate limit service) update the default
+	// order rate limit counters
+	var rlu common.RateLimitUpdater = rc
+	rlu.UpdateRateLimitsUsed([]common.RateLimitUpdate{
+		{EndpointType: common.EndpointTypeAPI, RateLimitType: common.RateLimitTypeUID, IntervalSeconds: 10, Count: 3},
+		{EndpointType: common.EndpointTypeAPI, RateLimitType: common.RateLimitTypeUID, IntervalSeconds: 86400, Count: 27},
+	}, 0)
+
+	rlc := rc.rlm.getRateLimitCounter(rateLimitKey{common.EndpointTypeAPI, common.RateLimitTypeUID, 10})
+	assert.NotNil(t, rlc)
+	assert.Equal(t, 100, rlc.limit)
+	assert.Equal(t, 3, rlc.countUsed)
+
+	rlc = rc.rlm.getRateLimitCounter(rateLimitKey{common.EndpointTypeAPI, common.RateLimitTypeUID, 86400})
+	assert.NotNil(t, rlc)
+	assert.Equal(t, 200000, rlc.limit)
+	assert.Equal(t, 27, rlc.countUsed)
+}
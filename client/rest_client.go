@@ -122,6 +122,12 @@ func (rc *restClient) doRequest(
 	return resp, nil
 }
 
+// UpdateRateLimitsUsed updates the used count of the rateLimitManager's
+// rate limit counters. It implements common.RateLimitUpdater.
+func (rc *restClient) UpdateRateLimitsUsed(rateLimitUpdates []common.RateLimitUpdate, tssResp common.TSNano) {
+	rc.rlm.UpdateUsed(rateLimitUpdates, tssResp)
+}
+
 // sign adds "timestamp", "recvWindow", and "signature" to urlValues.
 // NOTE: the "X-MBX-APIKEY" header is not added here.
 func (rc *restClient) sign(urlValues *url.Values) {
@@ -169,8 +175,9 @@ func (rc *restClient) createRequest(
 
 // errResponse is a helper struct used to parse binance error responses.
 type errResponse struct {
-	Code int    `json:"code"`
-	Msg  string `json:"msg"`
+	Code int             `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"` // (e.g. cancel-replace order results)
 }
 
 // newBadRequestError creates a new common.BadRequestError.
@@ -180,6 +187,7 @@ func (rc *restClient) newBadRequestError(statusCode int, errResp *errResponse) e
 		StatusCode: statusCode,
 		ErrorCode:  errResp.Code,
 		Msg:        errResp.Msg,
+		Data:       errResp.Data,
 	}
 }
 
@@ -190,6 +198,7 @@ func (rc *restClient) newUnexpectedStatusCodeError(statusCode int, errResp *errR
 		StatusCode: statusCode,
 		ErrorCode:  errResp.Code,
 		Msg:        errResp.Msg,
+		Data:       errResp.Data,
 	}
 }
 
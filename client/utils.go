@@ -12,13 +12,6 @@ import (
 	"github.com/svdro/shrimpy-binance/common"
 )
 
-// intervalSecondsMap is used for calclulating seconds from header.
-var intervalSecondsMap = map[common.BIRateLimitIntervalType]int{
-	common.IntervalSecond: 1,
-	common.IntervalMinute: 60,
-	common.IntervalDay:    60 * 60 * 24,
-}
-
 // intervalTypeMap is used for parsing intervalLetter from header.
 var intervalTypeMap = map[string]common.BIRateLimitIntervalType{
 	"s": common.IntervalSecond,
@@ -28,9 +21,7 @@ var intervalTypeMap = map[string]common.BIRateLimitIntervalType{
 
 // getSecondsInInterval returns the number of seconds in the given interval.
 func getSecondsInInterval(intervalType common.BIRateLimitIntervalType, intervalNumber int) int {
-	intervalTypeSeconds := intervalSecondsMap[intervalType]
-	intervalSeconds := intervalTypeSeconds * intervalNumber
-	return intervalSeconds
+	return intervalType.Seconds(intervalNumber)
 }
 
 var (
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== backoff ========================================== */
+
+// newBackoff creates a new backoff for a sequence of reconnect attempts.
+func newBackoff(policy BackoffPolicy, randFloat func() float64) *backoff {
+	if randFloat == nil {
+		randFloat = rand.Float64
+	}
+	return &backoff{
+		policy:    policy,
+		interval:  policy.InitialInterval,
+		prev:      policy.InitialInterval,
+		randFloat: randFloat,
+	}
+}
+
+// backoff calculates the intervals between reconnect attempts. The
+// exponential interval grows by BackoffPolicy.Multiplier after every attempt
+// and is capped at BackoffPolicy.MaxInterval. The JitterStrategy determines
+// how the interval that is actually waited for is derived from it.
+type backoff struct {
+	policy    BackoffPolicy
+	interval  time.Duration  // the exponential interval (without jitter)
+	prev      time.Duration  // the previous wait (used by JitterDecorrelated)
+	randFloat func() float64 // returns a random number in [0, 1)
+}
+
+// Next returns the duration to wait before the next attempt.
+func (b *backoff) Next() time.Duration {
+	var wait time.Duration
+
+	switch b.policy.Jitter {
+	case JitterFull:
+		wait = time.Duration(b.randFloat() * float64(b.interval))
+	case JitterEqual:
+		wait = b.interval/2 + time.Duration(b.randFloat()*float64(b.interval/2))
+	case JitterDecorrelated:
+		// with a Multiplier < 1, upper could fall below InitialInterval
+		upper := max(time.Duration(float64(b.prev)*b.policy.Multiplier), b.policy.InitialInterval)
+		wait = b.policy.InitialInterval + time.Duration(b.randFloat()*float64(upper-b.policy.InitialInterval))
+		if wait > b.policy.MaxInterval {
+			wait = b.policy.MaxInterval
+		}
+	default:
+		wait = b.interval
+	}
+
+	b.prev = wait
+	b.interval = increaseInterval(b.interval, b.policy)
+	return wait
+}
+
+// increaseInterval is a utility that increases the interval by the multiplier.
+// If the interval is greater than the maxInterval, it returns the maxInterval.
+func increaseInterval(current time.Duration, policy BackoffPolicy) time.Duration {
+	next := time.Duration(float64(current) * policy.Multiplier)
+	if next > policy.MaxInterval {
+		next = policy.MaxInterval
+	}
+	return next
+}
+
+/* ==================== reconnectBudget ================================== */
+
+// newReconnectBudget creates a new reconnectBudget.
+func newReconnectBudget(th common.TimeHandler, budget ReconnectBudget) *reconnectBudget {
+	return &reconnectBudget{th: th, budget: budget}
+}
+
+// reconnectBudget keeps track of the reconnect attempts of a stream within
+// the sliding window of a ReconnectBudget.
+type reconnectBudget struct {
+	th       common.TimeHandler
+	budget   ReconnectBudget
+	attempts []common.TSNano // attempt timestamps within the window
+}
+
+// Take registers a reconnect attempt. It returns false if the attempt would
+// exceed the budget, in which case it is not registered.
+func (b *reconnectBudget) Take() bool {
+	if b.budget.MaxAttempts <= 0 {
+		return true
+	}
+
+	// drop attempts that are no longer in the window
+	tslNow := b.th.TSLNow()
+	windowStart := tslNow - common.TSNano(b.budget.Interval)
+	for len(b.attempts) > 0 && b.attempts[0] <= windowStart {
+		b.attempts = b.attempts[1:]
+	}
+
+	if len(b.attempts) >= b.budget.MaxAttempts {
+		return false
+	}
+
+	b.attempts = append(b.attempts, tslNow)
+	return true
+}
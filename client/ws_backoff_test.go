@@ -0,0 +1,94 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := BackoffPolicy{InitialInterval: time.Second, MaxInterval: 8 * time.Second, Multiplier: 2}
+	half := func() float64 { return 0.5 }
+
+	tests := []struct {
+		jitter   JitterStrategy
+		expected []time.Duration
+	}{
+		{JitterNone, []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second}},
+		{JitterFull, []time.Duration{500 * time.Millisecond, 1 * time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}},
+		{JitterEqual, []time.Duration{750 * time.Millisecond, 1500 * time.Millisecond, 3 * time.Second, 6 * time.Second, 6 * time.Second}},
+		{JitterDecorrelated, []time.Duration{1500 * time.Millisecond, 2 * time.Second, 2500 * time.Millisecond, 3 * time.Second, 3500 * time.Millisecond}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.jitter.String(), func(t *testing.T) {
+			policy.Jitter = tt.jitter
+			b := newBackoff(policy, half)
+			for i, expected := range tt.expected {
+				assert.Equal(t, expected, b.Next(), "attempt %d", i)
+			}
+		})
+	}
+}
+
+func TestBackoffDecorrelatedMaxInterval(t *testing.T) {
+	policy := BackoffPolicy{
+		InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 3, Jitter: JitterDecorrelated,
+	}
+	b := newBackoff(policy, func() float64 { return 0.99 })
+	for i := 0; i < 10; i++ {
+		wait := b.Next()
+		assert.GreaterOrEqual(t, wait, policy.InitialInterval)
+		assert.LessOrEqual(t, wait, policy.MaxInterval)
+	}
+}
+
+func TestBackoffDecorrelatedMultiplierBelowOne(t *testing.T) {
+	policy := BackoffPolicy{
+		InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 0.5, Jitter: JitterDecorrelated,
+	}
+	b := newBackoff(policy, func() float64 { return 0.5 })
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, policy.InitialInterval, b.Next(), "attempt %d", i)
+	}
+}
+
+func TestReconnectBudget(t *testing.T) {
+	th := &mockTimeHandler{tsl: common.TSNano(time.Minute)}
+	b := newReconnectBudget(th, ReconnectBudget{MaxAttempts: 2, Interval: time.Minute})
+
+	assert.True(t, b.Take())
+	assert.True(t, b.Take())
+	assert.False(t, b.Take())
+
+	// the attempts leave the window
+	th.SetTSL(common.TSNano(2 * time.Minute))
+	assert.True(t, b.Take())
+
+	// a MaxAttempts of 0 disables the budget
+	b = newReconnectBudget(th, ReconnectBudget{})
+	for i := 0; i < 10; i++ {
+		assert.True(t, b.Take())
+	}
+}
+
+func TestNewStreamReconnectPolicy(t *testing.T) {
+	defaultPolicy := ReconnectPolicy{Enabled: true, MaxAttempts: -1}
+	userDataPolicy := ReconnectPolicy{Enabled: true, MaxAttempts: 3}
+	wc := newWSClient(
+		&timeHandler{}, WSConnOptions{}, WSConnLimits{}, defaultPolicy,
+		map[common.BIStreamType]ReconnectPolicy{common.StreamTypeUserData: userDataPolicy},
+		StaleStreamPolicy{}, log.NewEntry(log.StandardLogger()),
+	)
+
+	sm := common.NewStreamMeta(common.StreamDefinition{StreamType: common.StreamTypeUserData})
+	s := wc.NewStream(sm, newMockStreamHandler(), wc.logger).(*stream)
+	assert.Equal(t, userDataPolicy, s.reconnectPolicy)
+
+	sm = common.NewStreamMeta(common.StreamDefinition{StreamType: common.StreamTypeAggTrades})
+	s = wc.NewStream(sm, newMockStreamHandler(), wc.logger).(*stream)
+	assert.Equal(t, defaultPolicy, s.reconnectPolicy)
+}
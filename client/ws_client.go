@@ -11,14 +11,20 @@ import (
 func newWSClient(
 	th common.TimeHandler,
 	connOpts WSConnOptions,
+	connLimits WSConnLimits,
 	defaultReconnectPolicy ReconnectPolicy,
+	reconnectPolicies map[common.BIStreamType]ReconnectPolicy,
+	staleStreamPolicy StaleStreamPolicy,
 	logger *log.Entry,
 ) *wsClient {
 	logger = logger.WithField("_caller", "wsClient")
 	return &wsClient{
 		th:                     th,
 		connOpts:               connOpts,
+		cm:                     newWSConnManager(th, connLimits, logger),
 		defaultReconnectPolicy: defaultReconnectPolicy,
+		reconnectPolicies:      reconnectPolicies,
+		staleStreamPolicy:      staleStreamPolicy,
 		logger:                 logger,
 	}
 }
@@ -26,25 +32,39 @@ func newWSClient(
 // wsClient handles the creation of websocket streams
 // this is essentially a factory for websocket streams
 type wsClient struct {
-	th                     common.TimeHandler // needed for creating timestamps
-	connOpts               WSConnOptions      // websocket connection options
-	defaultReconnectPolicy ReconnectPolicy    // every stream has the same reconnect policy
-	logger                 *log.Entry         // logger
+	th                     common.TimeHandler                      // needed for creating timestamps
+	connOpts               WSConnOptions                           // websocket connection options
+	cm                     *wsConnManager                          // shared by all streams, enforces connection limits
+	defaultReconnectPolicy ReconnectPolicy                         // used by streams without a per stream type policy
+	reconnectPolicies      map[common.BIStreamType]ReconnectPolicy // per stream type reconnect policies
+	staleStreamPolicy      StaleStreamPolicy                       // every stream has the same stale stream policy
+	logger                 *log.Entry                              // logger
 }
 
 // NewStream creates a common.Stream
 func (wc *wsClient) NewStream(
 	sm *common.StreamMeta, handler common.StreamHandler, logger *log.Entry) common.Stream {
+	// use the reconnect policy of the stream type if there is one
+	reconnectPolicy := wc.defaultReconnectPolicy
+	if policy, ok := wc.reconnectPolicies[sm.SD.StreamType]; ok {
+		reconnectPolicy = policy
+	}
+
 	return &stream{
 		handler:          handler,
 		sm:               sm,
 		th:               wc.th,
 		connOpts:         wc.connOpts,
-		reconnectPolicy:  wc.defaultReconnectPolicy,
+		cm:               wc.cm,
+		reconnectPolicy:  reconnectPolicy,
+		budget:           newReconnectBudget(wc.th, reconnectPolicy.Budget),
+		stalePolicy:      wc.staleStreamPolicy,
 		pathFunc:         nil,
 		isRunning:        false,
 		isConnected:      false,
 		isConnectingChan: make(chan struct{}),
+		reconnectChan:    make(chan struct{}, 1),
+		lifecycleChan:    make(chan common.StreamLifecycleEvent, 64),
 		logger:           logger,
 	}
 }
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== wsConnManager ==================================== */
+
+// newWSConnManager creates a new wsConnManager.
+func newWSConnManager(th common.TimeHandler, limits WSConnLimits, logger *log.Entry) *wsConnManager {
+	return &wsConnManager{
+		th:          th,
+		limits:      limits,
+		dials:       make(map[common.BIWSEndpoint][]common.TSNano),
+		activeConns: make(map[common.BIWSEndpoint]int),
+		logger:      logger.WithField("_caller", "wsConnManager"),
+	}
+}
+
+// wsConnManager is shared by all streams of a wsClient. It enforces binance's
+// websocket connection limits:
+//   - the number of new connections per IP within a sliding window
+//     (e.g. 300 connections per 5 minutes). Dials that would exceed this
+//     limit are refused with a common.RateLimitError.
+//   - the number of messages sent per connection per second (e.g. 5). This
+//     includes pings, pongs and JSON control messages. Writes that would
+//     exceed this limit are delayed (see wsMessageLimiter).
+//
+// It also keeps track of the number of active connections per endpoint.
+type wsConnManager struct {
+	mu          sync.Mutex
+	th          common.TimeHandler
+	limits      WSConnLimits
+	dials       map[common.BIWSEndpoint][]common.TSNano // dial timestamps within the window
+	activeConns map[common.BIWSEndpoint]int
+	logger      *log.Entry
+}
+
+// newRateLimitError returns a new common.RateLimitError for a refused dial.
+func (cm *wsConnManager) newRateLimitError(tslRetryAt common.TSNano, count int) error {
+	reason := fmt.Sprintf("dial would exceed connection limit. (%d/ %d per %s)",
+		count+1, cm.limits.MaxConnAttempts, cm.limits.ConnAttemptsInterval)
+	return &common.RateLimitError{
+		StatusCode:     0,
+		ErrorCode:      0,
+		Msg:            reason,
+		Producer:       "shrimpy-binance",
+		RetryTimeLocal: time.Unix(0, tslRetryAt.Int64()),
+		RetryAfter:     int(tslRetryAt-cm.th.TSLNow()) / 1e9,
+	}
+}
+
+// RegisterDial registers a dial attempt to the endpoint. If the dial would
+// exceed the connection limit, it is not registered and a
+// common.RateLimitError is returned.
+func (cm *wsConnManager) RegisterDial(endpoint common.BIWSEndpoint) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	// don't do anything if the limit is disabled
+	if cm.limits.MaxConnAttempts <= 0 {
+		return nil
+	}
+
+	// drop dials that are no longer in the window
+	tslNow := cm.th.TSLNow()
+	windowStart := tslNow - common.TSNano(cm.limits.ConnAttemptsInterval)
+	dials := cm.dials[endpoint]
+	for len(dials) > 0 && dials[0] <= windowStart {
+		dials = dials[1:]
+	}
+	cm.dials[endpoint] = dials
+
+	// refuse the dial if it would exceed the limit. The dial can be retried
+	// once the oldest dial in the window has expired.
+	if len(dials) >= cm.limits.MaxConnAttempts {
+		tslRetryAt := dials[0] + common.TSNano(cm.limits.ConnAttemptsInterval)
+		err := cm.newRateLimitError(tslRetryAt, len(dials))
+		cm.logger.WithError(err).WithField("endpoint", endpoint).Warn("RegisterDial: refusing dial")
+		return err
+	}
+
+	cm.dials[endpoint] = append(dials, tslNow)
+	return nil
+}
+
+// RegisterConn registers an active connection to the endpoint.
+func (cm *wsConnManager) RegisterConn(endpoint common.BIWSEndpoint) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.activeConns[endpoint]++
+	cm.logger.WithFields(log.Fields{
+		"endpoint": endpoint, "activeConns": cm.activeConns[endpoint],
+	}).Debug("RegisterConn")
+}
+
+// UnregisterConn unregisters an active connection to the endpoint.
+func (cm *wsConnManager) UnregisterConn(endpoint common.BIWSEndpoint) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if cm.activeConns[endpoint] == 0 {
+		cm.logger.WithField("endpoint", endpoint).Error("UnregisterConn: no active connections. This should never happen")
+		return
+	}
+	cm.activeConns[endpoint]--
+}
+
+// ActiveConns returns the number of active connections to the endpoint.
+func (cm *wsConnManager) ActiveConns(endpoint common.BIWSEndpoint) int {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	return cm.activeConns[endpoint]
+}
+
+// NewMessageLimiter returns a wsMessageLimiter for a new connection.
+func (cm *wsConnManager) NewMessageLimiter() *wsMessageLimiter {
+	return newWSMessageLimiter(cm.limits.MaxMessagesPerSecond)
+}
+
+/* ==================== wsMessageLimiter ================================= */
+
+// newWSMessageLimiter creates a new wsMessageLimiter. If maxPerSecond is 0
+// or less, the limiter never waits.
+func newWSMessageLimiter(maxPerSecond int) *wsMessageLimiter {
+	return &wsMessageLimiter{maxPerSecond: maxPerSecond}
+}
+
+// wsMessageLimiter limits the number of messages written to a single
+// websocket connection per second. It keeps the timestamps of the last
+// maxPerSecond writes, and delays a write until the oldest of them is more
+// than a second old. It is used by wsPump.writePump (messages and pings) and
+// by wsPump's ping handler (pongs), which run on different goroutines.
+type wsMessageLimiter struct {
+	mu           sync.Mutex
+	maxPerSecond int
+	sent         []time.Time
+}
+
+// Wait blocks until a message can be written without exceeding the limit,
+// then registers the write. If the context is cancelled, it returns the
+// context error.
+func (l *wsMessageLimiter) Wait(ctx context.Context) error {
+	if l.maxPerSecond <= 0 {
+		return nil
+	}
+
+	// hold the lock while waiting, so that concurrent writes are delayed in
+	// the order they arrive
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.sent) >= l.maxPerSecond {
+		if d := time.Until(l.sent[0].Add(time.Second)); d > 0 {
+			if err := waitForInterval(ctx, d); err != nil {
+				return err
+			}
+		}
+		l.sent = l.sent[1:]
+	}
+
+	l.sent = append(l.sent, time.Now())
+	return nil
+}
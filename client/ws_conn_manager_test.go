@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+func TestRegisterDial(t *testing.T) {
+	th := &mockTimeHandler{tsl: common.TSNano(time.Minute)}
+	limits := WSConnLimits{MaxConnAttempts: 2, ConnAttemptsInterval: time.Minute}
+	cm := newWSConnManager(th, limits, log.NewEntry(log.StandardLogger()))
+	endpoint := common.WSEndpointAPI
+
+	// the first two dials are within the limit
+	assert.NoError(t, cm.RegisterDial(endpoint))
+	th.SetTSL(th.tsl + common.TSNano(10*time.Second))
+	assert.NoError(t, cm.RegisterDial(endpoint))
+
+	// the third dial is refused until the first dial leaves the window
+	err := cm.RegisterDial(endpoint)
+	rlErr, ok := err.(*common.RateLimitError)
+	assert.True(t, ok, "expected a RateLimitError, got %v", err)
+	assert.Equal(t, time.Unix(0, int64(2*time.Minute)), rlErr.RetryTimeLocal)
+	assert.Equal(t, 50, rlErr.RetryAfter)
+
+	// once the first dial has expired, the next dial is accepted
+	th.SetTSL(common.TSNano(2 * time.Minute))
+	assert.NoError(t, cm.RegisterDial(endpoint))
+
+	// a limit of 0 disables the check
+	cm = newWSConnManager(th, WSConnLimits{}, log.NewEntry(log.StandardLogger()))
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, cm.RegisterDial(endpoint))
+	}
+}
+
+func TestRegisterConn(t *testing.T) {
+	cm := newWSConnManager(&mockTimeHandler{}, WSConnLimits{}, log.NewEntry(log.StandardLogger()))
+	cm.RegisterConn(common.WSEndpointAPI)
+	cm.RegisterConn(common.WSEndpointAPI)
+	cm.UnregisterConn(common.WSEndpointAPI)
+	cm.UnregisterConn(common.WSEndpointFAPI) // never goes below 0
+
+	assert.Equal(t, 1, cm.ActiveConns(common.WSEndpointAPI))
+	assert.Equal(t, 0, cm.ActiveConns(common.WSEndpointFAPI))
+}
+
+func TestMessageLimiter(t *testing.T) {
+	l := newWSMessageLimiter(5)
+	ctx := context.Background()
+
+	// the first 5 messages are not delayed, the 6th waits for about a second
+	t0 := time.Now()
+	for i := 0; i < 6; i++ {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	assert.GreaterOrEqual(t, time.Since(t0), 900*time.Millisecond)
+
+	// Wait returns when the context is cancelled
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	l.sent = nil
+	for i := 0; i < 5; i++ {
+		l.sent = append(l.sent, time.Now())
+	}
+	assert.Error(t, l.Wait(ctx))
+}
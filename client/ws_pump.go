@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -10,7 +11,10 @@ import (
 
 // newWsPump creates a new wsPump
 func newWsPump(
-	conn *websocket.Conn, wsWriteWait, wsPongWait, wsPingPeriod time.Duration, logger *log.Entry,
+	conn *websocket.Conn,
+	wsWriteWait, wsPongWait, wsPingPeriod time.Duration,
+	limiter *wsMessageLimiter,
+	logger *log.Entry,
 ) *wsPump {
 	caller := "wsPump"
 	if c, ok := logger.Data["_caller"]; ok {
@@ -24,6 +28,7 @@ func newWsPump(
 		errChan:      make(chan error, 1),
 		writeChan:    make(chan []byte, 256),
 		readChan:     make(chan []byte, 256), // better safe than sorry
+		limiter:      limiter,
 		conn:         conn,
 		logger:       logger.WithField("_caller", caller),
 	}
@@ -34,13 +39,14 @@ func newWsPump(
 // closing it when necessary and propagating websocket errors to the
 // corresponding WsStream.
 type wsPump struct {
-	connOpts     WSConnOptions // websocket connection options
-	wsWriteWait  time.Duration // time to wait for a write
-	wsPongWait   time.Duration // time to wait for a pong
-	wsPingPeriod time.Duration // time between pings
-	errChan      chan error    // channel for writing errors
-	writeChan    chan []byte   // channel for writing to websocket
-	readChan     chan []byte   // channel for reading from websocket
+	connOpts     WSConnOptions     // websocket connection options
+	wsWriteWait  time.Duration     // time to wait for a write
+	wsPongWait   time.Duration     // time to wait for a pong
+	wsPingPeriod time.Duration     // time between pings
+	errChan      chan error        // channel for writing errors
+	writeChan    chan []byte       // channel for writing to websocket
+	readChan     chan []byte       // channel for reading from websocket
+	limiter      *wsMessageLimiter // limits messages written per second
 	conn         *websocket.Conn
 	logger       *log.Entry
 }
@@ -65,6 +71,7 @@ func (p *wsPump) readPump() {
 		p.logger.Trace("pong")
 		return nil
 	})
+	p.conn.SetPingHandler(p.handlePing)
 
 	for {
 		_, msg, err := p.conn.ReadMessage()
@@ -78,6 +85,24 @@ func (p *wsPump) readPump() {
 	}
 }
 
+// handlePing replaces gorilla's default ping handler, so that pongs are
+// counted by the wsMessageLimiter. Like the default handler, it ignores
+// errors that occur because the connection is closing or a write timed out.
+func (p *wsPump) handlePing(appData string) error {
+	if err := p.limiter.Wait(context.Background()); err != nil {
+		return err
+	}
+
+	err := p.conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(p.wsWriteWait))
+	if err == websocket.ErrCloseSent {
+		return nil
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return nil
+	}
+	return err
+}
+
 // writeWithDeadline writes a message to the websocket with a deadline
 func (p *wsPump) writeWithDeadline(msgType int, msg []byte) error {
 	p.conn.SetWriteDeadline(time.Now().Add(p.wsWriteWait))
@@ -109,6 +134,9 @@ func (p *wsPump) writePump(ctx context.Context) {
 				p.logger.Trace("writeChan closed. exiting writePump")
 				return
 			}
+			if err := p.limiter.Wait(ctx); err != nil {
+				continue // context done, write close message
+			}
 			if err := p.writeWithDeadline(websocket.TextMessage, msg); err != nil {
 				p.logger.WithError(err).Warn("error writing to websocket. exiting writePump")
 				return
@@ -116,6 +144,9 @@ func (p *wsPump) writePump(ctx context.Context) {
 
 		// send ping messages
 		case <-pingTicker.C:
+			if err := p.limiter.Wait(ctx); err != nil {
+				continue // context done, write close message
+			}
 			if err := p.writeWithDeadline(websocket.PingMessage, nil); err != nil {
 				p.logger.WithError(err).Warn("error writing ping message to websocket. exiting writePump")
 				return
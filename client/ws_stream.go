@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
@@ -26,14 +27,55 @@ func waitForInterval(ctx context.Context, interval time.Duration) error {
 	}
 }
 
-// increaseInterval is a utility that increases the interval by the multiplier.
-// If the interval is greater than the maxInterval, it returns the maxInterval.
-func increaseInterval(current time.Duration, policy BackoffPolicy) time.Duration {
-	next := time.Duration(float64(current) * policy.Multiplier)
-	if next > policy.MaxInterval {
-		next = policy.MaxInterval
+// errStaleStream is returned by stream.listen when the stale stream watchdog
+// fires.
+var errStaleStream = errors.New("no data received within stale stream timeout")
+
+// errReconnectRequested is returned by stream.listen when Reconnect was
+// called.
+var errReconnectRequested = errors.New("reconnect requested")
+
+// staleTimeout is a utility that calculates the stale stream timeout from
+// the policy and the update speed (milliseconds) of the stream. It returns 0
+// if the stream should not be watched.
+func staleTimeout(policy StaleStreamPolicy, updateSpeed int) time.Duration {
+	if !policy.Enabled {
+		return 0
+	}
+
+	// real-time streams have no fixed update interval
+	if updateSpeed == 0 {
+		return policy.RealTimeTimeout
+	}
+
+	timeout := time.Duration(policy.MaxMissedIntervals*updateSpeed) * time.Millisecond
+	if timeout < policy.MinTimeout {
+		timeout = policy.MinTimeout
+	}
+	return timeout
+}
+
+// resetTimer is a utility that stops, drains and resets the timer.
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
 	}
-	return next
+	t.Reset(d)
+}
+
+// dialRetryWait is a utility that returns how long to wait before retrying
+// a dial that failed with err. If the dial was refused by the wsConnManager
+// (a common.RateLimitError), it waits at least until the dial can be retried.
+func dialRetryWait(wait time.Duration, err error) time.Duration {
+	if rlErr, ok := err.(*common.RateLimitError); ok {
+		if untilRetry := time.Until(rlErr.RetryTimeLocal); untilRetry > wait {
+			return untilRetry
+		}
+	}
+	return wait
 }
 
 // incrConsecEarlyDisconnects is a utility that increments the counter if
@@ -53,15 +95,44 @@ type stream struct {
 	sm               *common.StreamMeta
 	th               common.TimeHandler
 	connOpts         WSConnOptions
+	cm               *wsConnManager
 	reconnectPolicy  ReconnectPolicy
+	budget           *reconnectBudget
+	stalePolicy      StaleStreamPolicy
 	pathFunc         func() string
 	pump             *wsPump
 	isConnectingChan chan struct{}
 	isConnected      bool
 	isRunning        bool
+	reconnectChan    chan struct{}
+	lifecycleChan    chan common.StreamLifecycleEvent
 	logger           *log.Entry
 }
 
+// LifecycleEvents returns a channel on which the stream publishes a
+// common.StreamLifecycleEvent every time its connection state changes.
+// The channel is buffered and never closed. If the consumer falls behind and
+// the buffer is full, events are dropped rather than blocking the stream.
+func (s *stream) LifecycleEvents() <-chan common.StreamLifecycleEvent {
+	return s.lifecycleChan
+}
+
+// publishLifecycleEvent puts a lifecycle event on the lifecycleChan
+// without blocking.
+func (s *stream) publishLifecycleEvent(event common.StreamLifecycleEvent) {
+	event.TSL = s.th.TSLNow()
+	select {
+	case s.lifecycleChan <- event:
+	default:
+		s.logger.WithField("event", event.Type).Warn("lifecycleChan is full. dropping lifecycle event")
+	}
+}
+
+// Status returns the stream's connection metadata collected in StreamMeta.
+func (s *stream) Status() common.StreamStatus {
+	return s.sm.Status(s.th.TSLNow())
+}
+
 func (s *stream) getIsConnectedStatus() bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -99,7 +170,8 @@ func (s *stream) closeIsConnectingChan(isConnected bool) {
 
 // SetPathFunc sets the pathFunc of the stream. This is necessary because
 // stream paths can contain symbols or other dynamic data that may not be known
-// when a stream is created.
+// when a stream is created. pathFunc is called before every (re)connect, so
+// it must be safe to call from the goroutine that runs the stream.
 func (s *stream) SetPathFunc(f func() string) {
 	if s.isRunning {
 		s.logger.Warn("cannot set pathFunc while stream is running")
@@ -108,6 +180,30 @@ func (s *stream) SetPathFunc(f func() string) {
 	s.pathFunc = f
 }
 
+// Reconnect closes the current connection, so that the stream reconnects
+// with a freshly built path (e.g. after the listen key of a user data stream
+// changed). The stream re-dials right away, even if the ReconnectPolicy is
+// disabled, and the disconnect does not count as an early disconnect. The
+// user is not notified with an error. Reconnect does not block. If the stream is not
+// connected, the next connection is closed, unless its path is built after
+// Reconnect was called.
+func (s *stream) Reconnect() {
+	select {
+	case s.reconnectChan <- struct{}{}:
+	default:
+	}
+}
+
+// discardReconnectRequests discards pending reconnect requests. It is called
+// right before the path is built, since the next connection is made with
+// the current path anyway.
+func (s *stream) discardReconnectRequests() {
+	select {
+	case <-s.reconnectChan:
+	default:
+	}
+}
+
 // getURI constructs the URI of the stream.
 // e.g. "wss://stream.binance.com:9443/ws/bnbbtc@aggTrade"
 func (s *stream) getURI() (url.URL, error) {
@@ -149,32 +245,100 @@ func (s *stream) WaitForConnection() <-chan bool {
 }
 
 // connect creates the websocket connection.
+// The dial is registered with the wsConnManager first. If it would exceed
+// the connection limit, a common.RateLimitError is returned.
 func (s *stream) connect(uri string) (*websocket.Conn, error) {
 	var conn *websocket.Conn
 	var err error
 
+	if err = s.cm.RegisterDial(s.sm.SD.Endpoint); err != nil {
+		return nil, err
+	}
+
 	conn, _, err = websocket.DefaultDialer.Dial(uri, nil)
 
 	// if the connection is successful, close the isConnectingChan
 	if err == nil {
+		s.cm.RegisterConn(s.sm.SD.Endpoint)
 		s.closeIsConnectingChan(true)
+		s.sm.RecordConnected(s.th.TSLNow())
+		s.publishLifecycleEvent(common.StreamLifecycleEvent{Type: common.StreamConnected})
 	}
 
 	return conn, err
 }
 
+// initialConnect creates the first websocket connection of Run. If the dial
+// is refused by the wsConnManager, it waits until the dial can be retried
+// (this happens when many streams are started at once). Any other error is
+// not retried. If the stream gives up, the isConnectingChan is closed and a
+// GaveUp lifecycle event is published. If the context is cancelled, it stops
+// without notifying the user.
+func (s *stream) initialConnect(ctx context.Context, uri string) (*websocket.Conn, error) {
+	for i := 0; ; i++ {
+		conn, err := s.connect(uri)
+		if err == nil {
+			return conn, nil
+		}
+
+		if _, ok := err.(*common.RateLimitError); !ok {
+			return nil, s.giveUp(s.newWSConnError(err, "failed to connect", 0, i, false))
+		}
+
+		// notify and wait until the dial can be retried
+		wait := dialRetryWait(0, err)
+		reason := fmt.Sprintf("dial refused, trying again in %s", wait)
+		s.handler.HandleError(s.newWSConnError(err, reason, 0, i+1, true))
+		s.logger.WithField("interval", wait).Debug(reason)
+
+		if err := waitForInterval(ctx, wait); err != nil {
+			return nil, s.stop(err)
+		}
+	}
+}
+
 // reconnectWithPolicy attempts to reconnect to the websocket connection
-// using the ReconnectPolicy (an exponential backoff strategy).
-// It notifies the user of each FAILED attempt.
+// using the ReconnectPolicy (an exponential backoff strategy with jitter).
+// If MaxAttempts is -1, it retries until the context is cancelled or the
+// reconnect budget is exhausted.
+// Every attempt (including the first) waits for the backoff interval, and a
+// Reconnecting lifecycle event is published before each wait. It notifies the
+// user of each FAILED attempt. If the stream gives up, the isConnectingChan
+// is closed and a GaveUp lifecycle event is published. If the context is
+// cancelled, it stops without notifying the user.
 func (s *stream) reconnectWithPolicy(
 	ctx context.Context, uri string, consecEarlyDisconnects int,
 ) (*websocket.Conn, error) {
 
-	interval := s.reconnectPolicy.BackoffPolicy.InitialInterval
+	maxAttempts := s.reconnectPolicy.MaxAttempts
+	b := newBackoff(s.reconnectPolicy.BackoffPolicy, nil)
+	wait := b.Next()
 
-	for i := 0; i < s.reconnectPolicy.MaxAttempts; i++ {
+	for i := 0; maxAttempts < 0 || i < maxAttempts; i++ {
 		logger := s.logger.WithField("attempt", i)
 
+		// give up if the context is cancelled or the budget is exhausted
+		if err := ctx.Err(); err != nil {
+			return nil, s.stop(err)
+		}
+		if !s.budget.Take() {
+			reason := fmt.Sprintf("reconnect budget exhausted (%d attempts per %s)",
+				s.reconnectPolicy.Budget.MaxAttempts, s.reconnectPolicy.Budget.Interval)
+			err := s.newWSConnError(errors.New(reason), "reconnect budget exhausted", consecEarlyDisconnects, i, false)
+			logger.WithError(err).Warn("failed to reconnect")
+			return nil, s.giveUp(err)
+		}
+
+		s.publishLifecycleEvent(common.StreamLifecycleEvent{
+			Type: common.StreamReconnecting, Attempt: i + 1, Backoff: wait,
+		})
+
+		// wait for interval
+		logger.WithField("interval", wait).Debug("waiting for next attempt to reconnect")
+		if err := waitForInterval(ctx, wait); err != nil {
+			return nil, s.stop(err)
+		}
+
 		// always return when connection is established
 		conn, err := s.connect(uri)
 		if err == nil {
@@ -182,33 +346,61 @@ func (s *stream) reconnectWithPolicy(
 			return conn, nil
 		}
 
-		// on last attempt, return error, close isConnectingChan
-		if i == s.reconnectPolicy.MaxAttempts-1 {
-			s.closeIsConnectingChan(false)
-
-			err = s.newWSConnError(err, "failed to reconnect", consecEarlyDisconnects, i+1, false)
+		// on last attempt, give up
+		if i == maxAttempts-1 {
+			err := s.newWSConnError(err, "failed to reconnect", consecEarlyDisconnects, i+1, false)
 			logger.WithError(err).Warn("failed to reconnect")
-			s.handler.HandleError(err)
-			return nil, err
+			return nil, s.giveUp(err)
 		}
 
-		// notify and wait for interval
-		reason := fmt.Sprintf("failed to reconnect, trying again in %s", interval)
+		// notify user of the failed attempt
+		wait = dialRetryWait(b.Next(), err)
+		reason := fmt.Sprintf("failed to reconnect, trying again in %s", wait)
 		err = s.newWSConnError(err, reason, consecEarlyDisconnects, i+1, true)
 		logger.WithError(err).Debug(reason)
 		s.handler.HandleError(err)
+	}
 
-		// wait for interval
-		logger.WithField("interval", interval).Debug("waiting for next attempt to reconnect")
-		if err := waitForInterval(ctx, interval); err != nil {
-			return nil, s.newWSConnError(err, "context cancelled", consecEarlyDisconnects, i+1, false)
+	return nil, fmt.Errorf("this is unreachable, but the compiler doesn't know that")
+}
+
+// reconnectNow re-dials right away after Reconnect was called, regardless of
+// the ReconnectPolicy. Like initialConnect, it waits if the dial is refused
+// by the wsConnManager. If the dial fails for any other reason, the stream
+// reconnects with the ReconnectPolicy if it is enabled, and gives up
+// otherwise.
+func (s *stream) reconnectNow(
+	ctx context.Context, uri string, consecEarlyDisconnects int,
+) (*websocket.Conn, error) {
+
+	var wait time.Duration
+	for i := 0; ; i++ {
+		s.publishLifecycleEvent(common.StreamLifecycleEvent{
+			Type: common.StreamReconnecting, Attempt: i + 1, Backoff: wait,
+		})
+		if err := waitForInterval(ctx, wait); err != nil {
+			return nil, s.stop(err)
 		}
 
-		// increase the interval for the next attemps
-		interval = increaseInterval(interval, s.reconnectPolicy.BackoffPolicy)
-	}
+		conn, err := s.connect(uri)
+		if err == nil {
+			return conn, nil
+		}
 
-	return nil, fmt.Errorf("this is unreachable, but the compiler doesn't know that")
+		if _, ok := err.(*common.RateLimitError); ok {
+			// notify and wait until the dial can be retried
+			wait = dialRetryWait(0, err)
+			reason := fmt.Sprintf("dial refused, trying again in %s", wait)
+			s.handler.HandleError(s.newWSConnError(err, reason, consecEarlyDisconnects, i+1, true))
+			continue
+		}
+
+		if !s.reconnectPolicy.Enabled {
+			return nil, s.giveUp(s.newWSConnError(err, "failed to reconnect", consecEarlyDisconnects, i+1, false))
+		}
+		s.handler.HandleError(s.newWSConnError(err, "failed to reconnect", consecEarlyDisconnects, i+1, true))
+		return s.reconnectWithPolicy(ctx, uri, consecEarlyDisconnects)
+	}
 }
 
 // newWSConnError is a utility for reating new WSConnErrors.
@@ -229,6 +421,16 @@ func (s *stream) newWSConnError(
 // isTransientConnError returns true if the error is in principle recoverable.
 // it also returns the reason for the error.
 func isTransientConnError(err error) (bool, string) {
+	// stale stream watchdog fired
+	if errors.Is(err, errStaleStream) {
+		return true, "stale stream"
+	}
+
+	// Reconnect was called
+	if errors.Is(err, errReconnectRequested) {
+		return true, "reconnect requested"
+	}
+
 	switch err := err.(type) {
 	// websocket closed
 	case *websocket.CloseError:
@@ -253,27 +455,26 @@ func isTransientConnError(err error) (bool, string) {
 // the correct common.WSConnError to the handler. Retruns true if the error is
 // transient, reconnectPolicy is enabled, and the maxConsecEarlyDisconnects is
 // not reached, indicating that the stream should attempt to reconnect.
-// Always notify the user with a common.WSConnError.
+// Always notify the user with a common.WSConnError. If the stream gives up,
+// the isConnectingChan is closed and a GaveUp lifecycle event is published.
 func (s *stream) handleConnError(err error, consecEarlyDisconnects int) bool {
 
 	// Check if the error is Transient
 	isTransient, reason := isTransientConnError(err)
 	if !isTransient {
-		s.handler.HandleError(s.newWSConnError(err, reason, consecEarlyDisconnects, 0, false))
+		s.giveUp(s.newWSConnError(err, reason, consecEarlyDisconnects, 0, false))
 		return false
 	}
 
 	// Check if the reconnectPolicy is enabled.
 	if !s.reconnectPolicy.Enabled {
-		err = s.newWSConnError(err, "reconnectPolicy disabled", consecEarlyDisconnects, 0, false)
-		s.handler.HandleError(err)
+		s.giveUp(s.newWSConnError(err, "reconnectPolicy disabled", consecEarlyDisconnects, 0, false))
 		return false
 	}
 
 	// Check if the maxConsecEarlyDisconnects is reached.
 	if consecEarlyDisconnects >= s.reconnectPolicy.MaxConsecEarlyDisconnects {
-		err = s.newWSConnError(err, "maxConsecEarlyDisconnects reached", consecEarlyDisconnects, 0, false)
-		s.handler.HandleError(err)
+		s.giveUp(s.newWSConnError(err, "maxConsecEarlyDisconnects reached", consecEarlyDisconnects, 0, false))
 		return false
 	}
 
@@ -283,19 +484,88 @@ func (s *stream) handleConnError(err error, consecEarlyDisconnects int) bool {
 	return true
 }
 
+// giveUp is called whenever the stream stops (re)connecting. It closes the
+// isConnectingChan, notifies the user and publishes a GaveUp lifecycle event.
+// It returns err for convenience.
+func (s *stream) giveUp(err *common.WSConnError) error {
+	s.closeIsConnectingChan(false)
+	s.handler.HandleError(err)
+	s.publishGaveUp(err, err.Reason)
+	return err
+}
+
+// stop is called when the stream shuts down, because the context was
+// cancelled. Unlike giveUp, it neither notifies the user nor publishes a
+// GaveUp lifecycle event. It only closes the isConnectingChan.
+// It returns err for convenience.
+func (s *stream) stop(err error) error {
+	s.closeIsConnectingChan(false)
+	return err
+}
+
+// publishGaveUp publishes a GaveUp lifecycle event.
+func (s *stream) publishGaveUp(err error, reason string) {
+	s.publishLifecycleEvent(common.StreamLifecycleEvent{
+		Type: common.StreamGaveUp, Reason: reason, Err: err,
+	})
+}
+
+// publishDisconnected publishes a Disconnected lifecycle event. The reason is
+// derived from the error that caused listen() to return.
+func (s *stream) publishDisconnected(err error) {
+	var reason string
+	if wshErr, ok := err.(*common.WSHandlerError); ok {
+		reason = wshErr.Reason
+	} else if err != nil {
+		_, reason = isTransientConnError(err)
+	}
+	s.publishLifecycleEvent(common.StreamLifecycleEvent{
+		Type: common.StreamDisconnected, Reason: reason, Err: err,
+	})
+}
+
 // listen listens for websocket messages and errors. It routes messages to
 // StreamHandler.HandleRecv(). On error, it returns the error, expecting the
 // caller to handle the error and decide whether or not to reconnect.
+// If the stale stream watchdog is enabled and no message is received within
+// the stale timeout, it closes the connection and returns errStaleStream.
+// If Reconnect is called, it closes the connection and returns
+// errReconnectRequested.
 func (s *stream) listen(p *wsPump, ctx context.Context) error {
 	go p.readPump()
 	go p.writePump(ctx)
 
 	ctxCancelled := false
 
+	// staleC is nil (blocks forever) if the watchdog is disabled
+	var staleTimer *time.Timer
+	var staleC <-chan time.Time
+	timeout := staleTimeout(s.stalePolicy, s.sm.SD.UpdateSpeed)
+	if timeout > 0 {
+		staleTimer = time.NewTimer(timeout)
+		defer staleTimer.Stop()
+		staleC = staleTimer.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			ctxCancelled = true
+			staleC = nil
+
+		case <-staleC:
+			// closing the connection causes readPump to exit. Don't wait for
+			// it here, cleanupPump will stop writePump.
+			s.logger.WithField("timeout", timeout).Warn("stale stream. closing connection")
+			p.conn.Close()
+			return fmt.Errorf("%w (%s)", errStaleStream, timeout)
+
+		case <-s.reconnectChan:
+			// like the stale stream watchdog, close the connection and let the
+			// caller reconnect.
+			s.logger.Debug("reconnect requested. closing connection")
+			p.conn.Close()
+			return errReconnectRequested
 
 		case err := <-p.errChan:
 			// err will be handled by the caller
@@ -313,13 +583,20 @@ func (s *stream) listen(p *wsPump, ctx context.Context) error {
 				continue
 			}
 
+			// every data message resets the stale stream watchdog
+			if staleC != nil {
+				resetTimer(staleTimer, timeout)
+			}
+
 			// handle recv
 			// if HandleRecv returns an error, it's always a common.WSHandlerError.
 			// If the error is not fatal, notify the user that it occured, but don't
 			// take any action. If the error is fatal, return it to the caller (Run()).
 			// Run() should shut down the stream and notify the user.
 			s.logger.WithField("msg", string(msg)).Trace("trying to handle recv")
-			if err := s.handler.HandleRecv(msg, s.th.TSLNow(), s.th.TSSNow()); err != nil {
+			tslRecv := s.th.TSLNow()
+			s.sm.RecordMessage(tslRecv)
+			if err := s.handler.HandleRecv(msg, tslRecv, s.th.TSLToTSS(tslRecv)); err != nil {
 				if err.IsFatal {
 					return err
 				}
@@ -337,6 +614,7 @@ func (s *stream) cleanupPump() {
 	pump := s.pump
 	s.pump = nil
 	close(pump.writeChan)
+	s.cm.UnregisterConn(s.sm.SD.Endpoint)
 }
 
 // Run starts the websocket stream and handles reconnections.
@@ -345,59 +623,90 @@ func (s *stream) Run(ctx context.Context) {
 	// set isRunning flag to true, and defer setting it to false
 	s.isRunning = true
 	s.isConnected = false
+	s.sm.RecordStarted(s.th.TSLNow())
+	s.publishLifecycleEvent(common.StreamLifecycleEvent{Type: common.StreamConnecting})
 	defer func() {
 		s.isRunning = false
 		s.isConnected = false
+		s.sm.RecordStopped()
+		s.publishLifecycleEvent(common.StreamLifecycleEvent{Type: common.StreamClosed})
 	}()
 
 	// if the setPathFunc is not set, don't run the stream
+	s.discardReconnectRequests()
 	uri, err := s.getURI()
 	if err != nil {
-		s.handler.HandleError(s.newWSConnError(err, "failed to get URI", 0, 0, false))
+		s.giveUp(s.newWSConnError(err, "failed to get URI", 0, 0, false))
 		return
 	}
 
 	// create websocket connection, init consecEarlyDisconnects counter
-	conn, err := s.connect(uri.String())
+	conn, err := s.initialConnect(ctx, uri.String())
 	var consecEarlyDisconnects int = 0
-
-	//conn, err := s.connect()
 	if err != nil {
-		s.handler.HandleError(s.newWSConnError(err, "failed to connect", 0, 0, false))
 		return
 	}
 
 	for {
 		// make a new wsPump, take the startTime  and listen()
-		s.pump = newWsPump(conn, s.connOpts.WSWriteWait, s.connOpts.WSPongWait, s.connOpts.WSPingPeriod, s.logger)
+		s.pump = newWsPump(
+			conn, s.connOpts.WSWriteWait, s.connOpts.WSPongWait, s.connOpts.WSPingPeriod,
+			s.cm.NewMessageLimiter(), s.logger,
+		)
 		t0 := time.Now()
 		err = s.listen(s.pump, ctx)
 
 		// reopen the isConnectingChan
 		s.openIsConnectingChan()
 
-		// incr or reset consecEarlyDisconnects counter based on MinConnDuration
-		consecEarlyDisconnects = incrConsecEarlyDisconnects(consecEarlyDisconnects, t0, s.reconnectPolicy.MinConnDuration)
+		// incr or reset consecEarlyDisconnects counter based on MinConnDuration.
+		// A requested reconnect is not an early disconnect.
+		if !errors.Is(err, errReconnectRequested) {
+			consecEarlyDisconnects = incrConsecEarlyDisconnects(consecEarlyDisconnects, t0, s.reconnectPolicy.MinConnDuration)
+		}
+		s.sm.RecordDisconnected(consecEarlyDisconnects)
+		s.publishDisconnected(err)
 
 		// cleanup s.pump and set it to nil
 		s.cleanupPump()
 
+		// if the context is cancelled, this is a normal shutdown. Don't notify
+		// the user of the error that closed the connection.
+		if ctx.Err() != nil {
+			s.stop(ctx.Err())
+			return
+		}
+
 		// if a common.WSHanlderError is returned, it's always fatal.
 		// shutdown the stream and notify the user.
 		if err, ok := err.(*common.WSHandlerError); ok {
+			s.closeIsConnectingChan(false)
 			s.handler.HandleError(err)
+			s.publishGaveUp(err, err.Reason)
 			return
 		}
 
 		// if it's not a common.WSHandlerError, it's an error that occured in
 		// wsPump, in which case it should be parsed to a common.WSConnError
-		// and handled accordingly.
-		if !s.handleConnError(err, consecEarlyDisconnects) {
+		// and handled accordingly. A requested reconnect is not an error, and
+		// doesn't consult the ReconnectPolicy.
+		requested := errors.Is(err, errReconnectRequested)
+		if !requested && !s.handleConnError(err, consecEarlyDisconnects) {
 			return
 		}
 
+		// rebuild the URI, since the path may have changed since the last
+		// connect (e.g. a user data stream with a new listen key). pathFunc is
+		// never nil here, so getURI can't fail.
+		s.discardReconnectRequests()
+		uri, _ = s.getURI()
+
 		// attempt to reconnect, Notify user!
-		if conn, err = s.reconnectWithPolicy(ctx, uri.String(), consecEarlyDisconnects); err != nil {
+		reconnect := s.reconnectWithPolicy
+		if requested {
+			reconnect = s.reconnectNow
+		}
+		if conn, err = reconnect(ctx, uri.String(), consecEarlyDisconnects); err != nil {
 			return
 		}
 	}
@@ -440,4 +749,15 @@ func (s *stream) SetReconnectPolicy(policy ReconnectPolicy) {
 		return
 	}
 	s.reconnectPolicy = policy
+	s.budget = newReconnectBudget(s.th, policy.Budget)
+}
+
+// SetStaleStreamPolicy allows the user to set a stale stream policy other
+// than the client's policy (e.g. a longer timeout for illiquid symbols).
+func (s *stream) SetStaleStreamPolicy(policy StaleStreamPolicy) {
+	if s.isRunning {
+		s.logger.Warn("cannot set stale stream policy while stream is running")
+		return
+	}
+	s.stalePolicy = policy
 }
@@ -0,0 +1,496 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+// mockStreamHandler is a mock implementation of common.StreamHandler that
+// collects all received messages and errors.
+type mockStreamHandler struct {
+	msgs chan []byte
+	errs chan error
+}
+
+func newMockStreamHandler() *mockStreamHandler {
+	return &mockStreamHandler{msgs: make(chan []byte, 16), errs: make(chan error, 16)}
+}
+
+func (h *mockStreamHandler) HandleSend(req common.WSRequest) *common.WSHandlerError { return nil }
+func (h *mockStreamHandler) HandleError(err error)                                  { h.errs <- err }
+func (h *mockStreamHandler) HandleRecv(msg []byte, TSLRecv, TSSRecv common.TSNano) *common.WSHandlerError {
+	h.msgs <- msg
+	return nil
+}
+
+// newMockWSServer starts a websocket server that writes msgs to every new
+// connection and then closes it.
+func newMockWSServer(t *testing.T, msgs ...string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		for _, msg := range msgs {
+			conn.WriteMessage(websocket.TextMessage, []byte(msg))
+		}
+		closeMsg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "bye")
+		conn.WriteMessage(websocket.CloseMessage, closeMsg)
+	}))
+}
+
+// newSilentMockWSServer starts a websocket server that writes msgs to every
+// new connection and then keeps it open without sending any data.
+func newSilentMockWSServer(t *testing.T, msgs ...string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		for _, msg := range msgs {
+			conn.WriteMessage(websocket.TextMessage, []byte(msg))
+		}
+		// reading handles pings until the client closes the connection
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+// newTestStream creates a stream that connects to the mock server.
+func newTestStream(server *httptest.Server, handler common.StreamHandler, policy ReconnectPolicy) *stream {
+	sd := common.StreamDefinition{Scheme: "ws", Endpoint: common.BIWSEndpoint(strings.TrimPrefix(server.URL, "http://"))}
+	connOpts := WSConnOptions{WSWriteWait: time.Second, WSPongWait: time.Second, WSPingPeriod: 800 * time.Millisecond}
+	logger := log.NewEntry(log.New())
+	wc := newWSClient(&timeHandler{}, connOpts, WSConnLimits{}, policy, nil, StaleStreamPolicy{}, logger)
+	s := wc.NewStream(common.NewStreamMeta(sd), handler, logger).(*stream)
+	s.SetPathFunc(func() string { return "/ws/test" })
+	return s
+}
+
+// drainLifecycleEvents returns the types of all events on the lifecycleChan.
+func drainLifecycleEvents(s *stream) []common.StreamLifecycleEventType {
+	var types []common.StreamLifecycleEventType
+	for {
+		select {
+		case event := <-s.LifecycleEvents():
+			types = append(types, event.Type)
+		default:
+			return types
+		}
+	}
+}
+
+func TestStreamLifecycleEventsAndStatus(t *testing.T) {
+	server := newMockWSServer(t, `{"e":"test"}`)
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	policy := ReconnectPolicy{
+		Enabled:                   true,
+		MaxAttempts:               1,
+		BackoffPolicy:             BackoffPolicy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 1},
+		MinConnDuration:           time.Hour,
+		MaxConsecEarlyDisconnects: 2,
+	}
+	s := newTestStream(server, handler, policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.Run(ctx)
+
+	// the server closes every connection after one message, so the stream
+	// reconnects once, and then gives up after two early disconnects.
+	expected := []common.StreamLifecycleEventType{
+		common.StreamConnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamReconnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamGaveUp,
+		common.StreamClosed,
+	}
+	assert.Equal(t, expected, drainLifecycleEvents(s))
+
+	status := s.Status()
+	assert.False(t, status.IsRunning)
+	assert.False(t, status.IsConnected)
+	assert.Equal(t, 2, status.ConnectionCount)
+	assert.Equal(t, 2, status.DisconnectCount)
+	assert.Equal(t, 2, status.ConsecEarlyDisconnects)
+	assert.Equal(t, 2, status.TotalEarlyDisconnects)
+	assert.NotZero(t, status.TSLLastMessage)
+	assert.Len(t, handler.msgs, 2)
+}
+
+func TestStaleTimeout(t *testing.T) {
+	policy := StaleStreamPolicy{Enabled: true, MaxMissedIntervals: 10, MinTimeout: 2 * time.Second, RealTimeTimeout: 0}
+	assert.Equal(t, 2*time.Second, staleTimeout(policy, 100))
+	assert.Equal(t, 10*time.Second, staleTimeout(policy, 1000))
+	assert.Equal(t, time.Duration(0), staleTimeout(policy, 0))
+
+	policy.RealTimeTimeout = time.Minute
+	assert.Equal(t, time.Minute, staleTimeout(policy, 0))
+
+	policy.Enabled = false
+	assert.Equal(t, time.Duration(0), staleTimeout(policy, 1000))
+}
+
+func TestStaleStreamWatchdog(t *testing.T) {
+	server := newSilentMockWSServer(t, `{"e":"test"}`)
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	policy := ReconnectPolicy{
+		Enabled:                   true,
+		MaxAttempts:               1,
+		BackoffPolicy:             BackoffPolicy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 1},
+		MinConnDuration:           0,
+		MaxConsecEarlyDisconnects: 1,
+	}
+	s := newTestStream(server, handler, policy)
+	s.sm.SD.UpdateSpeed = 10
+	s.SetStaleStreamPolicy(StaleStreamPolicy{Enabled: true, MaxMissedIntervals: 5, MinTimeout: 0})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go s.Run(ctx)
+
+	// the watchdog forces a reconnect and reports a transient WSConnError.
+	err := <-handler.errs
+	assert.IsType(t, &common.WSConnError{}, err)
+	assert.True(t, err.(*common.WSConnError).IsTransient)
+	assert.Equal(t, "stale stream", err.(*common.WSConnError).Reason)
+	assert.ErrorIs(t, err.(*common.WSConnError).Err, errStaleStream)
+
+	// the stream reconnects and receives the message again.
+	<-handler.msgs
+	<-handler.msgs
+	cancel()
+}
+
+func TestReconnectBudgetExhausted(t *testing.T) {
+	server := newMockWSServer(t, `{"e":"test"}`)
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	policy := ReconnectPolicy{
+		Enabled:                   true,
+		MaxAttempts:               -1,
+		BackoffPolicy:             BackoffPolicy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 1, Jitter: JitterFull},
+		MinConnDuration:           0,
+		MaxConsecEarlyDisconnects: 1,
+		Budget:                    ReconnectBudget{MaxAttempts: 2, Interval: time.Minute},
+	}
+	s := newTestStream(server, handler, policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.Run(ctx)
+
+	// the server closes every connection after one message, so the stream
+	// reconnects twice, and then gives up because the budget is exhausted.
+	expected := []common.StreamLifecycleEventType{
+		common.StreamConnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamReconnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamReconnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamGaveUp,
+		common.StreamClosed,
+	}
+	assert.Equal(t, expected, drainLifecycleEvents(s))
+	assert.Equal(t, 3, s.Status().ConnectionCount)
+}
+
+func TestReconnectRebuildsPath(t *testing.T) {
+	// the server records the path of every connection
+	paths := make(chan string, 16)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		closeMsg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "bye")
+		conn.WriteMessage(websocket.CloseMessage, closeMsg)
+	}))
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	policy := ReconnectPolicy{
+		Enabled:                   true,
+		MaxAttempts:               1,
+		BackoffPolicy:             BackoffPolicy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 1},
+		MinConnDuration:           time.Hour,
+		MaxConsecEarlyDisconnects: 2,
+	}
+	s := newTestStream(server, handler, policy)
+
+	// every call to pathFunc returns a new path (e.g. a new listen key)
+	calls := 0
+	s.SetPathFunc(func() string {
+		calls++
+		return fmt.Sprintf("/ws/key%d", calls)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.Run(ctx)
+
+	assert.Equal(t, "/ws/key1", <-paths)
+	assert.Equal(t, "/ws/key2", <-paths)
+}
+
+func TestHandleConnErrorNonTransientGivesUp(t *testing.T) {
+	server := newMockWSServer(t)
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	s := newTestStream(server, handler, ReconnectPolicy{Enabled: true, MaxAttempts: -1, MaxConsecEarlyDisconnects: 1})
+
+	assert.False(t, s.handleConnError(errors.New("unexpected error"), 0))
+
+	// WaitForConnection must not block after the stream gave up
+	select {
+	case isConnected := <-s.WaitForConnection():
+		assert.False(t, isConnected)
+	case <-time.After(time.Second):
+		t.Fatal("WaitForConnection blocked after the stream gave up")
+	}
+
+	events := drainLifecycleEvents(s)
+	assert.Equal(t, []common.StreamLifecycleEventType{common.StreamGaveUp}, events)
+	assert.Len(t, handler.errs, 1)
+}
+
+func TestInitialDialRefusedIsRetried(t *testing.T) {
+	server := newSilentMockWSServer(t)
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	s := newTestStream(server, handler, ReconnectPolicy{})
+
+	// the first dial is refused, because the dial limit is already reached
+	s.cm = newWSConnManager(&timeHandler{}, WSConnLimits{MaxConnAttempts: 1, ConnAttemptsInterval: 200 * time.Millisecond}, s.logger)
+	assert.NoError(t, s.cm.RegisterDial(s.sm.SD.Endpoint))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go s.Run(ctx)
+
+	select {
+	case isConnected := <-s.WaitForConnection():
+		assert.True(t, isConnected)
+	case <-time.After(2 * time.Second):
+		t.Fatal("WaitForConnection did not return")
+	}
+
+	err := <-handler.errs
+	var wsConnErr *common.WSConnError
+	assert.True(t, errors.As(err, &wsConnErr), "expected a WSConnError, got %v", err)
+	assert.True(t, wsConnErr.IsTransient)
+	assert.IsType(t, &common.RateLimitError{}, wsConnErr.Err)
+}
+
+func TestPongsAreCountedByMessageLimiter(t *testing.T) {
+	pongs := make(chan string, 3)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		conn.SetPongHandler(func(appData string) error { pongs <- appData; return nil })
+		for i := 0; i < 3; i++ {
+			conn.WriteControl(websocket.PingMessage, []byte(fmt.Sprint(i)), time.Now().Add(time.Second))
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.NoError(t, err)
+	limiter := newWSMessageLimiter(5)
+	p := newWsPump(conn, time.Second, time.Second, time.Second, limiter, log.NewEntry(log.New()))
+	go p.readPump()
+	defer conn.Close()
+
+	for i := 0; i < 3; i++ {
+		select {
+		case appData := <-pongs:
+			assert.Equal(t, fmt.Sprint(i), appData)
+		case <-time.After(time.Second):
+			t.Fatal("no pong received")
+		}
+	}
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	assert.Len(t, limiter.sent, 3)
+}
+
+func TestReconnectContextCancelledStops(t *testing.T) {
+	server := newMockWSServer(t)
+	defer server.Close()
+
+	// the first reconnect attempt waits for an hour
+	handler := newMockStreamHandler()
+	policy := ReconnectPolicy{
+		Enabled:                   true,
+		MaxAttempts:               -1,
+		BackoffPolicy:             BackoffPolicy{InitialInterval: time.Hour, MaxInterval: time.Hour, Multiplier: 1},
+		MaxConsecEarlyDisconnects: 10,
+	}
+	s := newTestStream(server, handler, policy)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() { s.Run(ctx); close(done) }()
+
+	// wait until the stream is waiting to reconnect, then cancel
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-s.LifecycleEvents():
+			if event.Type != common.StreamReconnecting {
+				continue
+			}
+			assert.Equal(t, time.Hour, event.Backoff)
+			cancel()
+		case <-deadline:
+			t.Fatal("stream did not start reconnecting")
+		}
+		break
+	}
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after the context was cancelled")
+	}
+	assert.False(t, <-s.WaitForConnection())
+	assert.Equal(t, []common.StreamLifecycleEventType{common.StreamClosed}, drainLifecycleEvents(s))
+
+	// only the transient error of the server closing the connection is handled
+	assert.Len(t, handler.errs, 1)
+	assert.True(t, (<-handler.errs).(*common.WSConnError).IsTransient)
+}
+
+func TestStreamShutdown(t *testing.T) {
+	server := newSilentMockWSServer(t)
+	defer server.Close()
+
+	handler := newMockStreamHandler()
+	policy := ReconnectPolicy{
+		Enabled:                   true,
+		MaxAttempts:               -1,
+		BackoffPolicy:             BackoffPolicy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 1},
+		MaxConsecEarlyDisconnects: 10,
+	}
+	s := newTestStream(server, handler, policy)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() { s.Run(ctx); close(done) }()
+	assert.True(t, <-s.WaitForConnection())
+
+	// cancelling the context is a normal shutdown: the user is not notified of
+	// any errors, and the stream doesn't give up.
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after the context was cancelled")
+	}
+	expected := []common.StreamLifecycleEventType{
+		common.StreamConnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamClosed,
+	}
+	assert.Equal(t, expected, drainLifecycleEvents(s))
+	assert.Len(t, handler.errs, 0)
+	assert.False(t, <-s.WaitForConnection())
+}
+
+func TestReconnect(t *testing.T) {
+	server := newSilentMockWSServer(t)
+	defer server.Close()
+
+	// a requested reconnect doesn't consult the ReconnectPolicy, so the stream
+	// reconnects even though reconnecting is disabled.
+	handler := newMockStreamHandler()
+	s := newTestStream(server, handler, ReconnectPolicy{Enabled: false})
+
+	// a request made before the path is built is discarded
+	s.Reconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan struct{})
+	go func() { s.Run(ctx); close(done) }()
+	assert.True(t, <-s.WaitForConnection())
+
+	for i := 0; i < 2; i++ {
+		s.Reconnect()
+		for s.Status().ConnectionCount < i+2 {
+			select {
+			case <-done:
+				t.Fatal("stream stopped instead of reconnecting")
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}
+
+	cancel()
+	<-done
+	expected := []common.StreamLifecycleEventType{
+		common.StreamConnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamReconnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamReconnecting,
+		common.StreamConnected,
+		common.StreamDisconnected,
+		common.StreamClosed,
+	}
+	assert.Equal(t, expected, drainLifecycleEvents(s))
+	assert.Equal(t, 3, s.Status().ConnectionCount)
+	assert.Equal(t, 0, s.Status().ConsecEarlyDisconnects)
+	assert.Len(t, handler.errs, 0)
+}
@@ -23,6 +23,7 @@ type BIRateLimitType string
 type BIHttpResponseCode int
 type BIWSEndpoint string
 type BIWSSecurityType int
+type BIStreamType string
 
 //func (t *BIRateLimitType) UnmarshalJSON(b []byte) error {
 //*t = BIRateLimitType(b)
@@ -72,18 +73,41 @@ const (
 
 	WSSecurityTypeNone BIWSSecurityType = iota
 	WSSecurityTypeListenKey
+
+	StreamTypeAggTrades      BIStreamType = "aggTrades"
+	StreamTypeDiffDepth      BIStreamType = "diffDepth"
+	StreamTypePartialDepth   BIStreamType = "partialDepth"
+	StreamTypeTrade          BIStreamType = "trade"
+	StreamTypeKline          BIStreamType = "kline"
+	StreamTypeBookTicker     BIStreamType = "bookTicker"
+	StreamTypeTicker         BIStreamType = "ticker"
+	StreamTypeMarkPrice      BIStreamType = "markPrice"
+	StreamTypeForceOrder     BIStreamType = "forceOrder"
+	StreamTypeCompositeIndex BIStreamType = "compositeIndex"
+	StreamTypeContractInfo   BIStreamType = "contractInfo"
+	StreamTypeUserData       BIStreamType = "userData"
+	StreamTypeWSAPI          BIStreamType = "wsAPI"
 )
 
 /* ==================== Order ============================================ */
 
-type BIOrderSide string               // (SPOT & MARGIN & FUTURES)
-type BIOrderType string               // (SPOT & MARGIN & FUTURES)
-type BIOrderResponseType string       // (SPOT & MARGIN & FUTURES)
-type BIOrderTimeInForce string        // (SPOT & MARGIN & FUTURES)
-type BIOrderStatus string             // (SPOT & MARGIN & FUTURES)
-type BISelfTradePreventionMode string // (SPOT & MARGIN & FUTURES)
-type BIOrderSideEffect string         //  (MARGIN)
-type BIExecutionType string           // (SPOT & MARGIN & FUTURES)
+type BIOrderSide string                  // (SPOT & MARGIN & FUTURES)
+type BIOrderType string                  // (SPOT & MARGIN & FUTURES)
+type BIOrderResponseType string          // (SPOT & MARGIN & FUTURES)
+type BIOrderTimeInForce string           // (SPOT & MARGIN & FUTURES)
+type BIOrderStatus string                // (SPOT & MARGIN & FUTURES)
+type BISelfTradePreventionMode string    // (SPOT & MARGIN & FUTURES)
+type BIOrderSideEffect string            //  (MARGIN)
+type BIExecutionType string              // (SPOT & MARGIN & FUTURES)
+type BIPositionSide string               // (FUTURES)
+type BIListStatusType string             // (SPOT & MARGIN)
+type BIListOrderStatus string            // (SPOT & MARGIN)
+type BIContingencyType string            // (SPOT & MARGIN)
+type BIWorkingType string                // (FUTURES)
+type BICancelRestriction string          // (SPOT)
+type BICancelReplaceMode string          // (SPOT)
+type BICancelReplaceResult string        // (SPOT)
+type BIOrderRateLimitExceededMode string // (SPOT)
 
 const (
 	OrderSideBuy  BIOrderSide = "BUY"  // (SPOT & MARGIN & FUTURES)
@@ -138,6 +162,100 @@ const (
 	ExecutionTypeTradePrevention BIExecutionType = "TRADE_PREVENTION" // (SPOT & MARGIN)
 	ExecutionTypeAmendment       BIExecutionType = "AMENDMENT"        // (FUTURES)
 	ExecutionTypeCalculated      BIExecutionType = "CALCULATED"       // (FUTURES)
+
+	ListStatusTypeResponse    BIListStatusType = "RESPONSE"     // (SPOT & MARGIN) Used when the list status is responding to a failed action
+	ListStatusTypeExecStarted BIListStatusType = "EXEC_STARTED" // (SPOT & MARGIN) The order list has been placed or there is an update to the order list status
+	ListStatusTypeAllDone     BIListStatusType = "ALL_DONE"     // (SPOT & MARGIN) The order list has finished executing and is no longer active
+
+	ListOrderStatusExecuting BIListOrderStatus = "EXECUTING" // (SPOT & MARGIN) Either an order list has been placed or there is an update to the status of the list
+	ListOrderStatusAllDone   BIListOrderStatus = "ALL_DONE"  // (SPOT & MARGIN) An order list has completed execution and is no longer active
+	ListOrderStatusReject    BIListOrderStatus = "REJECT"    // (SPOT & MARGIN) The order list was rejected
+
+	ContingencyTypeOCO BIContingencyType = "OCO" // (SPOT & MARGIN)
+	ContingencyTypeOTO BIContingencyType = "OTO" // (SPOT & MARGIN)
+
+	PositionSideBoth  BIPositionSide = "BOTH"  // (FUTURES) One-way mode
+	PositionSideLong  BIPositionSide = "LONG"  // (FUTURES) Hedge mode
+	PositionSideShort BIPositionSide = "SHORT" // (FUTURES) Hedge mode
+
+	WorkingTypeMarkPrice     BIWorkingType = "MARK_PRICE"     // (FUTURES)
+	WorkingTypeContractPrice BIWorkingType = "CONTRACT_PRICE" // (FUTURES)
+
+	CancelRestrictionOnlyNew             BICancelRestriction = "ONLY_NEW"              // (SPOT) Cancel will succeed if the order status is NEW
+	CancelRestrictionOnlyPartiallyFilled BICancelRestriction = "ONLY_PARTIALLY_FILLED" // (SPOT) Cancel will succeed if order status is PARTIALLY_FILLED
+
+	CancelReplaceModeStopOnFailure BICancelReplaceMode = "STOP_ON_FAILURE" // (SPOT) If the cancel request fails, the new order placement will not be attempted
+	CancelReplaceModeAllowFailure  BICancelReplaceMode = "ALLOW_FAILURE"   // (SPOT) New order placement will be attempted even if cancel request fails
+
+	CancelReplaceResultSuccess      BICancelReplaceResult = "SUCCESS"       // (SPOT)
+	CancelReplaceResultFailure      BICancelReplaceResult = "FAILURE"       // (SPOT)
+	CancelReplaceResultNotAttempted BICancelReplaceResult = "NOT_ATTEMPTED" // (SPOT)
+
+	OrderRateLimitExceededModeDoNothing  BIOrderRateLimitExceededMode = "DO_NOTHING"  // (SPOT) Only attempt to cancel the order if account has not exceeded the order rate limit
+	OrderRateLimitExceededModeCancelOnly BIOrderRateLimitExceededMode = "CANCEL_ONLY" // (SPOT) Always cancel the order
+)
+
+/* ==================== Margin =========================================== */
+
+type BIMarginLevelStatus string // (MARGIN)
+
+const (
+	MarginLevelStatusExcessive        BIMarginLevelStatus = "EXCESSIVE"         // (MARGIN)
+	MarginLevelStatusNormal           BIMarginLevelStatus = "NORMAL"            // (MARGIN)
+	MarginLevelStatusMarginCall       BIMarginLevelStatus = "MARGIN_CALL"       // (MARGIN)
+	MarginLevelStatusPreLiquidation   BIMarginLevelStatus = "PRE_LIQUIDATION"   // (MARGIN)
+	MarginLevelStatusForceLiquidation BIMarginLevelStatus = "FORCE_LIQUIDATION" // (MARGIN)
+)
+
+type BIMarginBorrowRepayType string   // (MARGIN)
+type BIMarginTransferType string      // (MARGIN)
+type BIMarginTransferDirection string // (MARGIN)
+
+const (
+	MarginBorrowRepayTypeBorrow BIMarginBorrowRepayType = "BORROW" // (MARGIN)
+	MarginBorrowRepayTypeRepay  BIMarginBorrowRepayType = "REPAY"  // (MARGIN)
+
+	MarginTransferTypeMainMargin                   BIMarginTransferType = "MAIN_MARGIN"                     // (MARGIN) Spot account -> cross margin account
+	MarginTransferTypeMarginMain                   BIMarginTransferType = "MARGIN_MAIN"                     // (MARGIN) Cross margin account -> spot account
+	MarginTransferTypeMainIsolatedMargin           BIMarginTransferType = "MAIN_ISOLATED_MARGIN"            // (MARGIN) Spot account -> isolated margin account
+	MarginTransferTypeIsolatedMarginMain           BIMarginTransferType = "ISOLATED_MARGIN_MAIN"            // (MARGIN) Isolated margin account -> spot account
+	MarginTransferTypeMarginIsolatedMargin         BIMarginTransferType = "MARGIN_ISOLATED_MARGIN"          // (MARGIN) Cross margin account -> isolated margin account
+	MarginTransferTypeIsolatedMarginMargin         BIMarginTransferType = "ISOLATED_MARGIN_MARGIN"          // (MARGIN) Isolated margin account -> cross margin account
+	MarginTransferTypeIsolatedMarginIsolatedMargin BIMarginTransferType = "ISOLATED_MARGIN_ISOLATED_MARGIN" // (MARGIN) Isolated margin account -> isolated margin account
+
+	MarginTransferDirectionRollIn  BIMarginTransferDirection = "ROLL_IN"  // (MARGIN) Transfers into a margin account
+	MarginTransferDirectionRollOut BIMarginTransferDirection = "ROLL_OUT" // (MARGIN) Transfers out of a margin account
+)
+
+/* ==================== Futures ========================================== */
+
+type BIFuturesMarginType string     // (FUTURES)
+type BIPositionMarginChangeType int // (FUTURES)
+type BIIncomeType string            // (FUTURES)
+
+const (
+	FuturesMarginTypeIsolated BIFuturesMarginType = "ISOLATED" // (FUTURES)
+	FuturesMarginTypeCrossed  BIFuturesMarginType = "CROSSED"  // (FUTURES)
+
+	PositionMarginChangeTypeAdd    BIPositionMarginChangeType = 1 // (FUTURES) Add isolated position margin
+	PositionMarginChangeTypeReduce BIPositionMarginChangeType = 2 // (FUTURES) Reduce isolated position margin
+
+	IncomeTypeTransfer                BIIncomeType = "TRANSFER"                  // (FUTURES)
+	IncomeTypeWelcomeBonus            BIIncomeType = "WELCOME_BONUS"             // (FUTURES)
+	IncomeTypeRealizedPnL             BIIncomeType = "REALIZED_PNL"              // (FUTURES)
+	IncomeTypeFundingFee              BIIncomeType = "FUNDING_FEE"               // (FUTURES)
+	IncomeTypeCommission              BIIncomeType = "COMMISSION"                // (FUTURES)
+	IncomeTypeInsuranceClear          BIIncomeType = "INSURANCE_CLEAR"           // (FUTURES)
+	IncomeTypeReferralKickback        BIIncomeType = "REFERRAL_KICKBACK"         // (FUTURES)
+	IncomeTypeCommissionRebate        BIIncomeType = "COMMISSION_REBATE"         // (FUTURES)
+	IncomeTypeAPIRebate               BIIncomeType = "API_REBATE"                // (FUTURES)
+	IncomeTypeContestReward           BIIncomeType = "CONTEST_REWARD"            // (FUTURES)
+	IncomeTypeCrossCollateralTransfer BIIncomeType = "CROSS_COLLATERAL_TRANSFER" // (FUTURES)
+	IncomeTypeInternalTransfer        BIIncomeType = "INTERNAL_TRANSFER"         // (FUTURES)
+	IncomeTypeAutoExchange            BIIncomeType = "AUTO_EXCHANGE"             // (FUTURES)
+	IncomeTypeCoinSwapDeposit         BIIncomeType = "COIN_SWAP_DEPOSIT"         // (FUTURES)
+	IncomeTypeCoinSwapWithdraw        BIIncomeType = "COIN_SWAP_WITHDRAW"        // (FUTURES)
+	IncomeTypeFeeReturn               BIIncomeType = "FEE_RETURN"                // (FUTURES)
 )
 
 /* ==================== ExchangeInfo ===================================== */
@@ -198,6 +316,58 @@ const (
 	ContractStatusClosed         BIContractStatus = "CLOSED"          // (FUTURES)
 )
 
+/* ==================== MarketData ====================================== */
+
+type BIKlineInterval string // (SPOT & MARGIN & FUTURES)
+
+const (
+	KlineInterval1s  BIKlineInterval = "1s"  // (SPOT & MARGIN)
+	KlineInterval1m  BIKlineInterval = "1m"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval3m  BIKlineInterval = "3m"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval5m  BIKlineInterval = "5m"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval15m BIKlineInterval = "15m" // (SPOT & MARGIN & FUTURES)
+	KlineInterval30m BIKlineInterval = "30m" // (SPOT & MARGIN & FUTURES)
+	KlineInterval1h  BIKlineInterval = "1h"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval2h  BIKlineInterval = "2h"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval4h  BIKlineInterval = "4h"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval6h  BIKlineInterval = "6h"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval8h  BIKlineInterval = "8h"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval12h BIKlineInterval = "12h" // (SPOT & MARGIN & FUTURES)
+	KlineInterval1d  BIKlineInterval = "1d"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval3d  BIKlineInterval = "3d"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval1w  BIKlineInterval = "1w"  // (SPOT & MARGIN & FUTURES)
+	KlineInterval1M  BIKlineInterval = "1M"  // (SPOT & MARGIN & FUTURES)
+)
+
+type BITickerWindowSize string // (SPOT & MARGIN)
+
+const (
+	TickerWindowSize1h BITickerWindowSize = "1h" // (SPOT & MARGIN)
+	TickerWindowSize4h BITickerWindowSize = "4h" // (SPOT & MARGIN)
+	TickerWindowSize1d BITickerWindowSize = "1d" // (SPOT & MARGIN)
+)
+
+type BITickerType string // (SPOT & MARGIN)
+
+const (
+	TickerTypeFull BITickerType = "FULL" // (SPOT & MARGIN)
+	TickerTypeMini BITickerType = "MINI" // (SPOT & MARGIN) omits price change and bid/ask fields
+)
+
+type BIFuturesStatsPeriod string // (FUTURES)
+
+const (
+	FuturesStatsPeriod5m  BIFuturesStatsPeriod = "5m"  // (FUTURES)
+	FuturesStatsPeriod15m BIFuturesStatsPeriod = "15m" // (FUTURES)
+	FuturesStatsPeriod30m BIFuturesStatsPeriod = "30m" // (FUTURES)
+	FuturesStatsPeriod1h  BIFuturesStatsPeriod = "1h"  // (FUTURES)
+	FuturesStatsPeriod2h  BIFuturesStatsPeriod = "2h"  // (FUTURES)
+	FuturesStatsPeriod4h  BIFuturesStatsPeriod = "4h"  // (FUTURES)
+	FuturesStatsPeriod6h  BIFuturesStatsPeriod = "6h"  // (FUTURES)
+	FuturesStatsPeriod12h BIFuturesStatsPeriod = "12h" // (FUTURES)
+	FuturesStatsPeriod1d  BIFuturesStatsPeriod = "1d"  // (FUTURES)
+)
+
 // this is only used in services for parsing, so it should not be here!
 //type BISymbolFilterType string // (SPOT & MARGIN & FUTURES)
 //const (
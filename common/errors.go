@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -21,6 +22,12 @@ type WSConnError struct {
 	IsTransient               bool   // if true, stream will attempt to reconnect
 }
 
+// Unwrap returns the underlying error (e.g. a RateLimitError if a dial was
+// refused because it would exceed the connection limit).
+func (e *WSConnError) Unwrap() error {
+	return e.Err
+}
+
 func (e *WSConnError) Error() string {
 	disconnects := fmt.Sprintf("(%d/%d)", e.ConsecEarlyDisconnects, e.MaxConsecEarlyDisconnects)
 	reconnects := fmt.Sprintf("(%d/%d)", e.ReconnectionAttempts, e.MaxReconnectionAttempts)
@@ -48,10 +55,13 @@ func (e *WSHandlerError) Error() string {
 // UnexpectedStatusCodeError is an error returned when the server returns a
 // status code that is not expected.
 // ErrorCode and Msg may be empty.
+// Data holds the data field of the error response, if any (e.g. a 409 from
+// a partially failed cancel-replace order).
 type UnexpectedStatusCodeError struct {
 	StatusCode int
 	ErrorCode  int
 	Msg        string
+	Data       json.RawMessage
 }
 
 func (e *UnexpectedStatusCodeError) Error() string {
@@ -112,10 +122,13 @@ func (e *RateLimitError) Error() string {
 // * 400 -> {"code":-1105,"msg":"This listenKey does not exist."}
 // * 400 -> {"code":-1125,"msg":"This listenKey does not exist."}
 // * 400 -> {"code":-1100,"msg":"Illegal characters found in parameter 'listenKey'; legal range is '^[a-zA-Z0-9]{1,60}$'."}
+// * e.g. Cancel-replace failed (Data holds the cancel and new order results)
+// * 400 -> {"code":-2022,"msg":"Order cancel-replace failed.","data":{...}}
 type BadRequestError struct {
 	StatusCode int
-	ErrorCode  int    `json:"code"`
-	Msg        string `json:"msg"`
+	ErrorCode  int             `json:"code"`
+	Msg        string          `json:"msg"`
+	Data       json.RawMessage `json:"data"`
 }
 
 func (e *BadRequestError) Error() string {
@@ -20,6 +20,13 @@ type RESTClient interface {
 	Do(ctx context.Context, sm *ServiceMeta, p url.Values) ([]byte, error)
 }
 
+// RateLimitUpdater is implemented by RESTClients that keep track of rate
+// limit usage. Services that read rate limit usage from a response body
+// (e.g. order rate limit services) pass it on through this interface.
+type RateLimitUpdater interface {
+	UpdateRateLimitsUsed(rateLimitUpdates []RateLimitUpdate, tssResp TSNano)
+}
+
 // WSClient
 type WSClient interface {
 	NewStream(sm *StreamMeta, handler StreamHandler, logger *log.Entry) Stream
@@ -54,4 +61,7 @@ type Stream interface {
 	Run(ctx context.Context)
 	SetPathFunc(f func() string)
 	WaitForConnection() <-chan bool
+	LifecycleEvents() <-chan StreamLifecycleEvent
+	Status() StreamStatus
+	Reconnect()
 }
@@ -2,6 +2,7 @@ package common
 
 import (
 	"encoding/json"
+	"sync"
 	"time"
 )
 
@@ -46,9 +47,14 @@ func (ts TSNano) String() string {
 }
 
 // UnmarshalJSON always unmarshals timestamps to nanoseconds.
+// Timestamps may also be quoted (e.g. the "E" field of the futures
+// listenKeyExpired event).
 // TODO: how does json.Unmarshal handle timestamps that are not in the message?
 func (ts *TSNano) UnmarshalJSON(data []byte) error {
 	var tsTmp int64
+	if len(data) > 1 && data[0] == '"' {
+		data = data[1 : len(data)-1]
+	}
 	if err := json.Unmarshal(data, &tsTmp); err != nil {
 		return err
 	}
@@ -73,6 +79,19 @@ type ServiceDefinition struct {
 	WeightUID           int // UIDLimit
 }
 
+// rateLimitIntervalSeconds is the number of seconds in each
+// BIRateLimitIntervalType.
+var rateLimitIntervalSeconds = map[BIRateLimitIntervalType]int{
+	IntervalSecond: 1,
+	IntervalMinute: 60,
+	IntervalDay:    60 * 60 * 24,
+}
+
+// Seconds returns the number of seconds in intervalNum intervals of t.
+func (t BIRateLimitIntervalType) Seconds(intervalNum int) int {
+	return rateLimitIntervalSeconds[t] * intervalNum
+}
+
 // RateLimitUpdate is used by ServiceResponseHeader
 // ServiceResponseHeader is used by ServiceMeta,
 // so this should be in common.
@@ -111,6 +130,7 @@ type ServiceMeta struct {
 
 // StreamDefinition holds all hardcoded data needed to create a stream.
 type StreamDefinition struct {
+	StreamType   BIStreamType // aggTrades, diffDepth, etc
 	Scheme       string
 	Endpoint     BIWSEndpoint
 	EndpointType BIEndpointType   // api, fapi, etc
@@ -125,10 +145,152 @@ func NewStreamMeta(sd StreamDefinition) *StreamMeta {
 // StreamMeta
 // Stream Meta persists over the lifetime of a stream.
 // As such, it cannot be used to store data on individual events.
-// Possibly use this later to collect metadata on a stream (e.g. disconnects,
-// reconnects, etc), and/or use this to configure the (dynamic) stream path.
+// It collects metadata on the stream's connections (e.g. connects,
+// disconnects, last message received), which is reported by Stream.Status().
 type StreamMeta struct {
 	SD StreamDefinition
+
+	mu                     sync.Mutex
+	isRunning              bool
+	isConnected            bool
+	tslStarted             TSNano // Run was called
+	tslConnected           TSNano // current connection was established
+	tslLastMessage         TSNano // last message was received
+	connectionCount        int    // successful connections (including reconnects)
+	disconnectCount        int    // disconnects of established connections
+	consecEarlyDisconnects int    // consecutive early disconnects
+	totalEarlyDisconnects  int    // early disconnects over the lifetime of the stream
+}
+
+// RecordStarted records that the stream has started running.
+func (sm *StreamMeta) RecordStarted(tsl TSNano) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.isRunning = true
+	sm.tslStarted = tsl
+}
+
+// RecordConnected records that a connection has been established.
+func (sm *StreamMeta) RecordConnected(tsl TSNano) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.isConnected = true
+	sm.tslConnected = tsl
+	sm.connectionCount++
+}
+
+// RecordDisconnected records that an established connection was lost.
+// consecEarlyDisconnects is the number of consecutive early disconnects
+// including this one (0 if this was not an early disconnect).
+func (sm *StreamMeta) RecordDisconnected(consecEarlyDisconnects int) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.isConnected = false
+	sm.disconnectCount++
+	sm.consecEarlyDisconnects = consecEarlyDisconnects
+	if consecEarlyDisconnects > 0 {
+		sm.totalEarlyDisconnects++
+	}
+}
+
+// RecordStopped records that the stream has stopped running.
+func (sm *StreamMeta) RecordStopped() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.isRunning = false
+	sm.isConnected = false
+}
+
+// RecordMessage records that a message was received.
+func (sm *StreamMeta) RecordMessage(tsl TSNano) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.tslLastMessage = tsl
+}
+
+// Status returns a StreamStatus. tslNow is used to calculate the uptime.
+func (sm *StreamMeta) Status(tslNow TSNano) StreamStatus {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	var uptime time.Duration
+	if sm.isConnected {
+		uptime = time.Duration(tslNow - sm.tslConnected)
+	}
+
+	return StreamStatus{
+		IsRunning:              sm.isRunning,
+		IsConnected:            sm.isConnected,
+		Uptime:                 uptime,
+		TSLStarted:             sm.tslStarted,
+		TSLConnected:           sm.tslConnected,
+		TSLLastMessage:         sm.tslLastMessage,
+		ConnectionCount:        sm.connectionCount,
+		DisconnectCount:        sm.disconnectCount,
+		ConsecEarlyDisconnects: sm.consecEarlyDisconnects,
+		TotalEarlyDisconnects:  sm.totalEarlyDisconnects,
+	}
+}
+
+// StreamStatus is a point in time summary of a stream's connection metadata.
+// TSLConnected and TSLLastMessage are 0 if the stream has never connected or
+// never received a message.
+type StreamStatus struct {
+	IsRunning              bool
+	IsConnected            bool
+	Uptime                 time.Duration // duration of the current connection
+	TSLStarted             TSNano        // timestamp local Run was called
+	TSLConnected           TSNano        // timestamp local the last connection was established
+	TSLLastMessage         TSNano        // timestamp local the last message was received
+	ConnectionCount        int           // successful connections (including reconnects)
+	DisconnectCount        int           // disconnects of established connections
+	ConsecEarlyDisconnects int           // consecutive early disconnects
+	TotalEarlyDisconnects  int           // early disconnects over the lifetime of the stream
+}
+
+/* ==================== Stream Lifecycle ================================= */
+
+// StreamLifecycleEventType is the type of a StreamLifecycleEvent.
+type StreamLifecycleEventType int
+
+const (
+	StreamConnecting   StreamLifecycleEventType = iota // initial connection attempt
+	StreamConnected                                    // connection established
+	StreamDisconnected                                 // established connection lost
+	StreamReconnecting                                 // reconnection attempt
+	StreamGaveUp                                       // reconnect policy exhausted, or disabled
+	StreamClosed                                       // stream.Run returned
+)
+
+func (t StreamLifecycleEventType) String() string {
+	switch t {
+	case StreamConnecting:
+		return "Connecting"
+	case StreamConnected:
+		return "Connected"
+	case StreamDisconnected:
+		return "Disconnected"
+	case StreamReconnecting:
+		return "Reconnecting"
+	case StreamGaveUp:
+		return "GaveUp"
+	case StreamClosed:
+		return "Closed"
+	}
+	return "Unknown"
+}
+
+// StreamLifecycleEvent is published by a Stream whenever its connection
+// state changes. Only the fields relevant to the event type are set:
+//   - Disconnected, GaveUp: Reason, Err
+//   - Reconnecting: Attempt, Backoff (the time the stream waits before this attempt)
+type StreamLifecycleEvent struct {
+	Type    StreamLifecycleEventType
+	TSL     TSNano // timestamp local of the state change
+	Reason  string
+	Err     error
+	Attempt int
+	Backoff time.Duration
 }
 
 // StreamEventMeta holds metadata on a single websocket event,
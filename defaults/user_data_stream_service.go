@@ -0,0 +1,323 @@
+package defaults
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	binance "github.com/svdro/shrimpy-binance"
+	bc "github.com/svdro/shrimpy-binance/common"
+	bsv "github.com/svdro/shrimpy-binance/services"
+	bst "github.com/svdro/shrimpy-binance/streams"
+)
+
+const (
+	// listenKeyKeepAliveInterval is the interval at which listen keys are
+	// kept alive. Listen keys expire after 60 minutes without a keepalive.
+	listenKeyKeepAliveInterval = 30 * time.Minute
+
+	// listenKeyCloseTimeout is the timeout for closing the listen key, after
+	// the UserDataStreamService's context is cancelled.
+	listenKeyCloseTimeout = 5 * time.Second
+
+	// errCodeListenKeyDoesNotExist is returned by the server when pinging a
+	// listen key that does not exist (anymore).
+	errCodeListenKeyDoesNotExist = -1125
+)
+
+/* ==================== Constructors ===================================== */
+
+// NewSpotUserDataStreamService creates a new UserDataStreamService for the
+// spot user data stream.
+func NewSpotUserDataStreamService(
+	client *binance.Client, logger *log.Entry,
+) *UserDataStreamService[*bst.SpotUserDataStream] {
+	stream := client.NewSpotUserDataStream()
+	return newUserDataStreamService(
+		stream,
+		stream.Handler.ListenKeyExpiredEventChan,
+		client.NewSpotCreateListenKeyService(),
+		client.NewSpotPingListenKeyService(),
+		client.NewSpotCloseListenKeyService(),
+		logger.WithField("_caller", "SpotUserDataStreamService"),
+	)
+}
+
+// NewMarginUserDataStreamService creates a new UserDataStreamService for the
+// cross margin user data stream.
+func NewMarginUserDataStreamService(
+	client *binance.Client, logger *log.Entry,
+) *UserDataStreamService[*bst.MarginUserDataStream] {
+	stream := client.NewMarginUserDataStream()
+	return newUserDataStreamService(
+		stream,
+		stream.Handler.ListenKeyExpiredEventChan,
+		client.NewMarginCreateListenKeyService(),
+		client.NewMarginPingListenKeyService(),
+		client.NewMarginCloseListenKeyService(),
+		logger.WithField("_caller", "MarginUserDataStreamService"),
+	)
+}
+
+// NewIsolatedMarginUserDataStreamService creates a new UserDataStreamService
+// for the isolated margin user data stream of symbol.
+func NewIsolatedMarginUserDataStreamService(
+	client *binance.Client, symbol string, logger *log.Entry,
+) *UserDataStreamService[*bst.MarginUserDataStream] {
+	stream := client.NewMarginUserDataStream()
+	return newUserDataStreamService(
+		stream,
+		stream.Handler.ListenKeyExpiredEventChan,
+		client.NewIsolatedMarginCreateListenKeyService().WithSymbol(symbol),
+		client.NewIsolatedMarginPingListenKeyService().WithSymbol(symbol),
+		client.NewIsolatedMarginCloseListenKeyService().WithSymbol(symbol),
+		logger.WithFields(log.Fields{"_caller": "IsolatedMarginUserDataStreamService", "_symbol": symbol}),
+	)
+}
+
+// NewFuturesUserDataStreamService creates a new UserDataStreamService for the
+// futures user data stream.
+func NewFuturesUserDataStreamService(
+	client *binance.Client, logger *log.Entry,
+) *UserDataStreamService[*bst.FuturesUserDataStream] {
+	stream := client.NewFuturesUserDataStream()
+	return newUserDataStreamService(
+		stream,
+		stream.Handler.ListenKeyExpiredEventChan,
+		client.NewFuturesCreateListenKeyService(),
+		client.NewFuturesPingListenKeyService(),
+		client.NewFuturesCloseListenKeyService(),
+		logger.WithField("_caller", "FuturesUserDataStreamService"),
+	)
+}
+
+// newUserDataStreamService creates a new UserDataStreamService.
+func newUserDataStreamService[S bc.Stream](
+	stream S,
+	listenKeyExpiredChan <-chan *bst.ListenKeyExpiredEvent,
+	createService *bsv.CreateListenKeyService,
+	pingService *bsv.PingListenKeyService,
+	closeService *bsv.CloseListenKeyService,
+	logger *log.Entry,
+) *UserDataStreamService[S] {
+	s := &UserDataStreamService[S]{
+		Stream:               stream,
+		ErrChan:              make(chan error, 1),
+		listenKeyExpiredChan: listenKeyExpiredChan,
+		createService:        createService,
+		pingService:          pingService,
+		closeService:         closeService,
+		errorPolicy:          &defaultErrorPolicy{},
+		keepAliveInterval:    listenKeyKeepAliveInterval,
+		logger:               logger,
+	}
+	stream.SetPathFunc(s.path)
+	return s
+}
+
+/* ==================== UserDataStreamService ============================ */
+
+// UserDataStreamService runs a user data stream and manages the lifecycle
+// of its listen key. It creates the listen key, keeps it alive, and creates
+// a new listen key when the old one expired (listenKeyExpired event) or does
+// not exist anymore (-1125 on keepalive). After a new listen key is created,
+// the stream is reconnected. The stream path is rebuilt from the current
+// listen key on every reconnect, so events from the new listen key arrive on
+// the same Stream.Handler channels.
+//
+// Consumers read events and stream errors from Stream.Handler. The
+// Handler's ListenKeyExpiredEventChan is consumed by the service, and must
+// not be read by the consumer.
+//
+// ErrChan receives the error that caused Run to return (if any).
+// This is intended to be run only once. If UserDataStreamService.Run
+// returns, make a new UserDataStreamService and run it again.
+type UserDataStreamService[S bc.Stream] struct {
+	Stream               S
+	ErrChan              chan error
+	listenKeyExpiredChan <-chan *bst.ListenKeyExpiredEvent
+	createService        *bsv.CreateListenKeyService
+	pingService          *bsv.PingListenKeyService
+	closeService         *bsv.CloseListenKeyService
+	errorPolicy          ErrorPolicy
+	keepAliveInterval    time.Duration
+	mu                   sync.Mutex
+	listenKey            string
+	logger               *log.Entry
+}
+
+// ListenKey returns the current listen key.
+func (s *UserDataStreamService[S]) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+// setListenKey sets the current listen key.
+func (s *UserDataStreamService[S]) setListenKey(listenKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listenKey = listenKey
+}
+
+// path is the path function of the stream. It is called by the stream
+// before every (re)connect, and always returns the current listen key.
+func (s *UserDataStreamService[S]) path() string {
+	return bst.UserDataPath(s.ListenKey())
+}
+
+// createListenKey creates a new listen key and sets it as the current
+// listen key. It retries transient errors (as determined by the errorPolicy),
+// and returns non-transient errors.
+func (s *UserDataStreamService[S]) createListenKey(ctx context.Context) error {
+	for {
+		resp, err := s.createService.Do(ctx)
+		if err == nil {
+			s.setListenKey(resp.ListenKey)
+			s.logger.Debug("created listen key")
+			return nil
+		}
+
+		// consult error policy to determine if the error is transient, and how
+		// long to wait before retrying.
+		t, d, r := s.errorPolicy.Handle(err)
+		s.logger.WithFields(log.Fields{"reason": r, "isTransient": t, "waitDuration": d}).WithError(err).Error("createListenKey")
+		if !t {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+		}
+	}
+}
+
+// renewListenKey creates a new listen key and reconnects the stream, so that
+// it receives the events of the new listen key.
+func (s *UserDataStreamService[S]) renewListenKey(ctx context.Context) error {
+	if err := s.createListenKey(ctx); err != nil {
+		return err
+	}
+	s.Stream.Reconnect()
+	return nil
+}
+
+// keepAlive pings the current listen key. If the listen key does not exist
+// anymore, the listen key is renewed. Other errors are logged and ignored,
+// the listen key is pinged again on the next tick (the listen key stays
+// valid for 60 minutes).
+func (s *UserDataStreamService[S]) keepAlive(ctx context.Context) error {
+	_, err := s.pingService.WithListenKey(s.ListenKey()).Do(ctx)
+	if err == nil {
+		s.logger.Trace("listen key kept alive")
+		return nil
+	}
+
+	var badRequestErr *bc.BadRequestError
+	if errors.As(err, &badRequestErr) && badRequestErr.ErrorCode == errCodeListenKeyDoesNotExist {
+		s.logger.WithError(err).Warn("keepAlive: listen key does not exist. creating new listen key")
+		return s.renewListenKey(ctx)
+	}
+
+	s.logger.WithError(err).Warn("keepAlive: failed to keep listen key alive. retrying on next tick")
+	return nil
+}
+
+// handleListenKeyExpired renews the listen key, if the expired listen key is
+// the current listen key. The server does not close the connection after the
+// listenKeyExpired event, it just stops sending events on it. So the stream
+// must be reconnected with the new listen key.
+func (s *UserDataStreamService[S]) handleListenKeyExpired(ctx context.Context, event *bst.ListenKeyExpiredEvent) error {
+	if event.ListenKey != "" && event.ListenKey != s.ListenKey() {
+		s.logger.Debug("handleListenKeyExpired: ignoring event for an old listen key")
+		return nil
+	}
+	s.logger.Warn("handleListenKeyExpired: listen key expired. creating new listen key")
+	return s.renewListenKey(ctx)
+}
+
+// closeListenKey closes the current listen key. It is called when Run
+// returns, so it uses its own context.
+func (s *UserDataStreamService[S]) closeListenKey() {
+	ctx, cancel := context.WithTimeout(context.Background(), listenKeyCloseTimeout)
+	defer cancel()
+
+	if _, err := s.closeService.WithListenKey(s.ListenKey()).Do(ctx); err != nil {
+		s.logger.WithError(err).Warn("closeListenKey: failed to close listen key")
+	}
+}
+
+// exit puts err on the ErrChan, if it is not nil.
+func (s *UserDataStreamService[S]) exit(err error) {
+	if err == nil {
+		return
+	}
+	s.logger.WithError(err).Error("Run: exiting")
+	select {
+	case s.ErrChan <- err:
+	default:
+	}
+}
+
+// Run creates the listen key, runs the stream and keeps the listen key
+// alive. It returns when ctx is cancelled, when the stream stops, or when a
+// new listen key can't be created. The listen key is closed on return.
+func (s *UserDataStreamService[S]) Run(ctx context.Context, wg *sync.WaitGroup) {
+	// runCtx is needed for closing the stream when an unexpected error occurs
+	runCtx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		wg.Done()
+	}()
+
+	// create the initial listen key
+	if err := s.createListenKey(runCtx); err != nil {
+		s.exit(err)
+		return
+	}
+
+	// start the stream, the listen key is closed after the stream stopped.
+	streamDone := make(chan struct{})
+	go func() {
+		s.Stream.Run(runCtx)
+		close(streamDone)
+	}()
+	defer func() {
+		cancel()
+		<-streamDone
+		s.closeListenKey()
+	}()
+
+	// keep the listen key alive
+	ticker := time.NewTicker(s.keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-streamDone:
+			if ctx.Err() == nil {
+				s.exit(errors.New("user data stream stopped"))
+			}
+			return
+
+		case <-ticker.C:
+			if err := s.keepAlive(runCtx); err != nil {
+				s.exit(err)
+				return
+			}
+
+		case event := <-s.listenKeyExpiredChan:
+			if err := s.handleListenKeyExpired(runCtx, event); err != nil {
+				s.exit(err)
+				return
+			}
+		}
+	}
+}
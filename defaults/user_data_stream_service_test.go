@@ -0,0 +1,140 @@
+package defaults
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	bc "github.com/svdro/shrimpy-binance/common"
+	bsv "github.com/svdro/shrimpy-binance/services"
+	bst "github.com/svdro/shrimpy-binance/streams"
+)
+
+// mockListenKeyRestClient creates listen keys "key-1", "key-2", ...
+// The first ping returns pingErr. It records the listenKey param of every
+// ping and close request.
+type mockListenKeyRestClient struct {
+	mu      sync.Mutex
+	created int
+	pingErr error
+	pinged  []string
+	closed  []string
+}
+
+func (m *mockListenKeyRestClient) Do(ctx context.Context, sm *bc.ServiceMeta, p url.Values) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch sm.SD.Method {
+	case http.MethodPost:
+		m.created++
+		return []byte(fmt.Sprintf(`{"listenKey": "key-%d"}`, m.created)), nil
+	case http.MethodPut:
+		m.pinged = append(m.pinged, p.Get("listenKey"))
+		err := m.pingErr
+		m.pingErr = nil
+		return []byte(`{}`), err
+	default:
+		m.closed = append(m.closed, p.Get("listenKey"))
+		return []byte(`{}`), nil
+	}
+}
+
+// mockUserDataStream is a mock implementation of common.Stream. Run blocks
+// until the context is cancelled. Every call to Reconnect is sent to
+// reconnects, together with the path the stream would reconnect with.
+type mockUserDataStream struct {
+	pathFunc   func() string
+	reconnects chan string
+}
+
+func (s *mockUserDataStream) Run(ctx context.Context)                         { <-ctx.Done() }
+func (s *mockUserDataStream) SetPathFunc(f func() string)                     { s.pathFunc = f }
+func (s *mockUserDataStream) WaitForConnection() <-chan bool                  { return nil }
+func (s *mockUserDataStream) LifecycleEvents() <-chan bc.StreamLifecycleEvent { return nil }
+func (s *mockUserDataStream) Status() bc.StreamStatus                         { return bc.StreamStatus{} }
+func (s *mockUserDataStream) Reconnect()                                      { s.reconnects <- s.pathFunc() }
+
+// newTestUserDataStreamService creates a UserDataStreamService with spot
+// listen key services that use rc.
+func newTestUserDataStreamService(
+	rc bc.RESTClient, listenKeyExpiredChan chan *bst.ListenKeyExpiredEvent, keepAliveInterval time.Duration,
+) (*UserDataStreamService[*mockUserDataStream], *mockUserDataStream) {
+	logger := log.NewEntry(log.New())
+	stream := &mockUserDataStream{reconnects: make(chan string, 8)}
+	s := newUserDataStreamService(
+		stream,
+		listenKeyExpiredChan,
+		bsv.NewSpotCreateListenKeyService(rc, logger),
+		bsv.NewSpotPingListenKeyService(rc, logger),
+		bsv.NewSpotCloseListenKeyService(rc, logger),
+		logger,
+	)
+	s.keepAliveInterval = keepAliveInterval
+	return s, stream
+}
+
+// waitForReconnect returns the path of the next reconnect of stream.
+func waitForReconnect(t *testing.T, stream *mockUserDataStream) string {
+	select {
+	case path := <-stream.reconnects:
+		return path
+	case <-time.After(time.Second):
+		t.Fatal("stream was not reconnected")
+		return ""
+	}
+}
+
+func TestUserDataStreamServiceListenKeyDoesNotExist(t *testing.T) {
+	rc := &mockListenKeyRestClient{
+		pingErr: &bc.BadRequestError{StatusCode: 400, ErrorCode: errCodeListenKeyDoesNotExist, Msg: "This listenKey does not exist."},
+	}
+	s, stream := newTestUserDataStreamService(rc, make(chan *bst.ListenKeyExpiredEvent), 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go s.Run(ctx, wg)
+
+	// the first keepalive fails with -1125, the stream is reconnected with a
+	// new listen key
+	assert.Equal(t, "/ws/key-2", waitForReconnect(t, stream))
+	assert.Equal(t, "key-2", s.ListenKey())
+
+	cancel()
+	wg.Wait()
+	assert.Len(t, s.ErrChan, 0)
+	assert.Len(t, stream.reconnects, 0)
+	assert.Equal(t, "key-1", rc.pinged[0])
+	assert.Equal(t, []string{"key-2"}, rc.closed)
+}
+
+func TestUserDataStreamServiceListenKeyExpired(t *testing.T) {
+	rc := &mockListenKeyRestClient{}
+	listenKeyExpiredChan := make(chan *bst.ListenKeyExpiredEvent)
+	s, stream := newTestUserDataStreamService(rc, listenKeyExpiredChan, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go s.Run(ctx, wg)
+
+	// events for an old listen key are ignored
+	listenKeyExpiredChan <- &bst.ListenKeyExpiredEvent{ListenKey: "key-0"}
+	listenKeyExpiredChan <- &bst.ListenKeyExpiredEvent{ListenKey: "key-1"}
+	assert.Equal(t, "/ws/key-2", waitForReconnect(t, stream))
+	assert.Equal(t, "key-2", s.ListenKey())
+
+	cancel()
+	wg.Wait()
+	assert.Len(t, s.ErrChan, 0)
+	assert.Len(t, stream.reconnects, 0)
+	assert.Equal(t, 2, rc.created)
+	assert.Equal(t, []string{"key-2"}, rc.closed)
+}
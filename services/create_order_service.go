@@ -254,80 +254,3 @@ func (s CreateMarginOrderService) WithNewClientOrderId(newClientOrderId string)
 	s.newClientOrderID = &newClientOrderId
 	return &s
 }
-
-//[> ==================== CancelMarginOrderService ==========================<]
-
-//// CancelMarginOrderService
-//// (symbolREST and either orderId or origClientOrderId must be sent)
-//type CancelMarginOrderService struct {
-//api *API
-//ServiceDefinition
-//symbolREST        string
-//isIsolated        *string
-//orderID           *string
-//origClientOrderID *string
-//newClientOrderID  *string
-//}
-
-//// Do sends the request and returns a CancelMarginOrderEvent.
-//func (s *CancelMarginOrderService) Do(ctx context.Context) (*CancelMarginOrderEvent, error) {
-//p := s.toParams()
-//resp, reqMetaData, err := s.api.Do(ctx, &s.ServiceDefinition, p)
-//if err != nil {
-//return nil, err
-//}
-
-//e := &CancelMarginOrderEvent{}
-//e.RequestMetaData = reqMetaData
-//err = json.Unmarshal(resp, e)
-//return e, err
-//}
-
-//// toParams converts all parameter fields of the service to a params struct.
-//func (s *CancelMarginOrderService) toParams() params {
-//p := params{}
-//p.set("symbol", s.symbolREST)
-//p.setIfNotNil("isIsolated", s.isIsolated)
-//p.setIfNotNil("orderId", s.orderID)
-//p.setIfNotNil("origClientOrderId", s.origClientOrderID)
-//p.setIfNotNil("newClientOrderId", s.newClientOrderID)
-//return p
-//}
-
-//func (s *CancelMarginOrderService) GetParams() params {
-//return s.toParams()
-//}
-
-//// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
-//func (s CancelMarginOrderService) WithSymbol(symbolREST string) *CancelMarginOrderService {
-//s.symbolREST = symbolREST
-//return &s
-//}
-
-//// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
-//func (s CancelMarginOrderService) WithIsIsolated(isIsolated bool) *CancelMarginOrderService {
-//isIsolatedStr := boolToUpperStr(isIsolated)
-//s.isIsolated = &isIsolatedStr
-//return &s
-//}
-
-//// WithOrderID returns a copy of the service with orderID set to the given value.
-//func (s CancelMarginOrderService) WithOrderID(orderID int64) *CancelMarginOrderService {
-//orderIDStr := strconv.FormatInt(orderID, 10)
-//s.orderID = &orderIDStr
-//return &s
-//}
-
-//// WithOrigClientOrderID returns a copy of the service with origClientOrderID
-//// set to the given value.
-//func (s CancelMarginOrderService) WithOrigClientOrderID(origClientOrderID string) *CancelMarginOrderService {
-//s.origClientOrderID = &origClientOrderID
-//return &s
-//}
-
-//// WithNewClientOrderID returns a copy of the service with newClientOrderID
-//// set to the given value.
-//func (s CancelMarginOrderService) WithNewClientOrderID(newClientOrderID string) *CancelMarginOrderService {
-//s.newClientOrderID = &newClientOrderID
-//return &s
-//}
@@ -0,0 +1,1045 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== FuturesAccountService ============================ */
+
+// FuturesAccountAsset is an asset of a futures account.
+type FuturesAccountAsset struct {
+	Asset                  string        `json:"asset"`
+	WalletBalance          string        `json:"walletBalance"`
+	UnrealizedProfit       string        `json:"unrealizedProfit"`
+	MarginBalance          string        `json:"marginBalance"`
+	MaintMargin            string        `json:"maintMargin"`
+	InitialMargin          string        `json:"initialMargin"`
+	PositionInitialMargin  string        `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string        `json:"openOrderInitialMargin"`
+	CrossWalletBalance     string        `json:"crossWalletBalance"`
+	CrossUnPnl             string        `json:"crossUnPnl"`
+	AvailableBalance       string        `json:"availableBalance"`
+	MaxWithdrawAmount      string        `json:"maxWithdrawAmount"`
+	MarginAvailable        bool          `json:"marginAvailable"` // (v2 only)
+	TSSUpdate              common.TSNano `json:"updateTime"`
+}
+
+// FuturesAccountPosition is a position of a futures account.
+type FuturesAccountPosition struct {
+	Symbol                 string                `json:"symbol"`
+	PositionSide           common.BIPositionSide `json:"positionSide"`
+	PositionAmt            string                `json:"positionAmt"`
+	UnrealizedProfit       string                `json:"unrealizedProfit"`
+	IsolatedMargin         string                `json:"isolatedMargin"`
+	Notional               string                `json:"notional"`
+	IsolatedWallet         string                `json:"isolatedWallet"`
+	InitialMargin          string                `json:"initialMargin"`
+	MaintMargin            string                `json:"maintMargin"`
+	PositionInitialMargin  string                `json:"positionInitialMargin"`  // (v2 only)
+	OpenOrderInitialMargin string                `json:"openOrderInitialMargin"` // (v2 only)
+	Leverage               string                `json:"leverage"`               // (v2 only)
+	IsIsolated             bool                  `json:"isolated"`               // (v2 only)
+	EntryPrice             string                `json:"entryPrice"`             // (v2 only)
+	BreakEvenPrice         string                `json:"breakEvenPrice"`         // (v2 only)
+	MaxNotional            string                `json:"maxNotional"`            // (v2 only)
+	BidNotional            string                `json:"bidNotional"`            // (v2 only)
+	AskNotional            string                `json:"askNotional"`            // (v2 only)
+	TSSUpdate              common.TSNano         `json:"updateTime"`
+}
+
+// FuturesAccountResponse is the response of a FuturesAccountService.
+// v3 only returns positions with a position or open orders, v2 returns all
+// positions.
+type FuturesAccountResponse struct {
+	ServiceBaseResponse
+	FeeTier                     int                      `json:"feeTier"`           // (v2 only)
+	CanTrade                    bool                     `json:"canTrade"`          // (v2 only)
+	CanDeposit                  bool                     `json:"canDeposit"`        // (v2 only)
+	CanWithdraw                 bool                     `json:"canWithdraw"`       // (v2 only)
+	MultiAssetsMargin           bool                     `json:"multiAssetsMargin"` // (v2 only)
+	TradeGroupID                int64                    `json:"tradeGroupId"`      // (v2 only)
+	TotalInitialMargin          string                   `json:"totalInitialMargin"`
+	TotalMaintMargin            string                   `json:"totalMaintMargin"`
+	TotalWalletBalance          string                   `json:"totalWalletBalance"`
+	TotalUnrealizedProfit       string                   `json:"totalUnrealizedProfit"`
+	TotalMarginBalance          string                   `json:"totalMarginBalance"`
+	TotalPositionInitialMargin  string                   `json:"totalPositionInitialMargin"`
+	TotalOpenOrderInitialMargin string                   `json:"totalOpenOrderInitialMargin"`
+	TotalCrossWalletBalance     string                   `json:"totalCrossWalletBalance"`
+	TotalCrossUnPnl             string                   `json:"totalCrossUnPnl"`
+	AvailableBalance            string                   `json:"availableBalance"`
+	MaxWithdrawAmount           string                   `json:"maxWithdrawAmount"`
+	Assets                      []FuturesAccountAsset    `json:"assets"`
+	Positions                   []FuturesAccountPosition `json:"positions"`
+}
+
+// FuturesAccountService gets the current futures account information.
+type FuturesAccountService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+}
+
+// Do sends the request and returns a FuturesAccountResponse.
+func (s *FuturesAccountService) Do(ctx context.Context) (*FuturesAccountResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesAccountService) toParams() params {
+	return params{}
+}
+
+func (s *FuturesAccountService) parseResponse(data []byte) (*FuturesAccountResponse, error) {
+	resp := &FuturesAccountResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== FuturesBalanceService ============================ */
+
+// FuturesAssetBalance is the balance of an asset of a futures account.
+type FuturesAssetBalance struct {
+	AccountAlias       string        `json:"accountAlias"`
+	Asset              string        `json:"asset"`
+	Balance            string        `json:"balance"`
+	CrossWalletBalance string        `json:"crossWalletBalance"`
+	CrossUnPnl         string        `json:"crossUnPnl"`
+	AvailableBalance   string        `json:"availableBalance"`
+	MaxWithdrawAmount  string        `json:"maxWithdrawAmount"`
+	MarginAvailable    bool          `json:"marginAvailable"`
+	TSSUpdate          common.TSNano `json:"updateTime"`
+}
+
+// FuturesBalanceResponse is the response of a FuturesBalanceService.
+type FuturesBalanceResponse struct {
+	ServiceBaseResponse
+	Balances []FuturesAssetBalance
+}
+
+// FuturesBalanceService gets the balances of a futures account.
+type FuturesBalanceService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+}
+
+// Do sends the request and returns a FuturesBalanceResponse.
+func (s *FuturesBalanceService) Do(ctx context.Context) (*FuturesBalanceResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesBalanceService) toParams() params {
+	return params{}
+}
+
+func (s *FuturesBalanceService) parseResponse(data []byte) (*FuturesBalanceResponse, error) {
+	resp := &FuturesBalanceResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Balances); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== FuturesPositionRiskService ======================= */
+
+// FuturesPositionRisk is the current position information of a symbol.
+type FuturesPositionRisk struct {
+	Symbol                 string                `json:"symbol"`
+	PositionSide           common.BIPositionSide `json:"positionSide"`
+	PositionAmt            string                `json:"positionAmt"`
+	EntryPrice             string                `json:"entryPrice"`
+	BreakEvenPrice         string                `json:"breakEvenPrice"`
+	MarkPrice              string                `json:"markPrice"`
+	UnrealizedProfit       string                `json:"unRealizedProfit"`
+	LiquidationPrice       string                `json:"liquidationPrice"`
+	IsolatedMargin         string                `json:"isolatedMargin"`
+	Notional               string                `json:"notional"`
+	MarginAsset            string                `json:"marginAsset"`
+	IsolatedWallet         string                `json:"isolatedWallet"`
+	InitialMargin          string                `json:"initialMargin"`
+	MaintMargin            string                `json:"maintMargin"`
+	PositionInitialMargin  string                `json:"positionInitialMargin"`
+	OpenOrderInitialMargin string                `json:"openOrderInitialMargin"`
+	ADL                    int                   `json:"adl"` // auto-deleveraging quantile
+	BidNotional            string                `json:"bidNotional"`
+	AskNotional            string                `json:"askNotional"`
+	TSSUpdate              common.TSNano         `json:"updateTime"`
+}
+
+// FuturesPositionRiskResponse is the response of a FuturesPositionRiskService.
+type FuturesPositionRiskResponse struct {
+	ServiceBaseResponse
+	Positions []FuturesPositionRisk
+}
+
+// FuturesPositionRiskService gets the current position information of a
+// symbol, or of all symbols with a position or open orders if no symbol is
+// set.
+type FuturesPositionRiskService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns a FuturesPositionRiskResponse.
+func (s *FuturesPositionRiskService) Do(ctx context.Context) (*FuturesPositionRiskResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesPositionRiskService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+func (s *FuturesPositionRiskService) parseResponse(data []byte) (*FuturesPositionRiskResponse, error) {
+	resp := &FuturesPositionRiskResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Positions); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesPositionRiskService) WithSymbolREST(symbolREST string) *FuturesPositionRiskService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+/* ==================== ChangeFuturesLeverageService ===================== */
+
+// ChangeFuturesLeverageResponse is the response of a
+// ChangeFuturesLeverageService.
+type ChangeFuturesLeverageResponse struct {
+	ServiceBaseResponse
+	Symbol           string `json:"symbol"`
+	Leverage         int    `json:"leverage"`
+	MaxNotionalValue string `json:"maxNotionalValue"`
+}
+
+// ChangeFuturesLeverageService changes the initial leverage of a symbol.
+type ChangeFuturesLeverageService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	leverage   string // (1 - 125)
+}
+
+// Do sends the request and returns a ChangeFuturesLeverageResponse.
+func (s *ChangeFuturesLeverageService) Do(ctx context.Context) (*ChangeFuturesLeverageResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *ChangeFuturesLeverageService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("leverage", s.leverage)
+	return p
+}
+
+func (s *ChangeFuturesLeverageService) parseResponse(data []byte) (*ChangeFuturesLeverageResponse, error) {
+	resp := &ChangeFuturesLeverageResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s ChangeFuturesLeverageService) WithSymbolREST(symbolREST string) *ChangeFuturesLeverageService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithLeverage returns a copy of the service with leverage set to the given value.
+func (s ChangeFuturesLeverageService) WithLeverage(leverage int) *ChangeFuturesLeverageService {
+	s.leverage = strconv.Itoa(leverage)
+	return &s
+}
+
+/* ==================== ChangeFuturesMarginTypeService =================== */
+
+// ChangeFuturesMarginTypeService changes the margin type (ISOLATED, CROSSED)
+// of a symbol.
+type ChangeFuturesMarginTypeService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	marginType string // (ISOLATED, CROSSED)
+}
+
+// Do sends the request and returns a FuturesCodeResponse.
+func (s *ChangeFuturesMarginTypeService) Do(ctx context.Context) (*FuturesCodeResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesCodeResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *ChangeFuturesMarginTypeService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("marginType", s.marginType)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s ChangeFuturesMarginTypeService) WithSymbolREST(symbolREST string) *ChangeFuturesMarginTypeService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithMarginType returns a copy of the service with marginType set to the
+// given value.
+func (s ChangeFuturesMarginTypeService) WithMarginType(marginType common.BIFuturesMarginType) *ChangeFuturesMarginTypeService {
+	s.marginType = string(marginType)
+	return &s
+}
+
+/* ==================== ModifyFuturesPositionMarginService =============== */
+
+// ModifyFuturesPositionMarginResponse is the response of a
+// ModifyFuturesPositionMarginService.
+type ModifyFuturesPositionMarginResponse struct {
+	ServiceBaseResponse
+	Amount           json.Number                       `json:"amount"`
+	Code             int                               `json:"code"`
+	Msg              string                            `json:"msg"`
+	MarginChangeType common.BIPositionMarginChangeType `json:"type"`
+}
+
+// ModifyFuturesPositionMarginService adds margin to or reduces margin of an
+// isolated position. (symbolREST, amount and marginChangeType must be sent,
+// positionSide is mandatory in hedge mode)
+type ModifyFuturesPositionMarginService struct {
+	SM               common.ServiceMeta
+	rc               common.RESTClient
+	logger           *log.Entry
+	symbolREST       string
+	amount           string
+	marginChangeType string  // (1: add, 2: reduce)
+	positionSide     *string // (BOTH, LONG, SHORT) (default: BOTH)
+}
+
+// Do sends the request and returns a ModifyFuturesPositionMarginResponse.
+func (s *ModifyFuturesPositionMarginService) Do(ctx context.Context) (*ModifyFuturesPositionMarginResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *ModifyFuturesPositionMarginService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("amount", s.amount)
+	p.Set("type", s.marginChangeType)
+	p.SetIfNotNil("positionSide", s.positionSide)
+	return p
+}
+
+func (s *ModifyFuturesPositionMarginService) parseResponse(data []byte) (*ModifyFuturesPositionMarginResponse, error) {
+	resp := &ModifyFuturesPositionMarginResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithPositionMarginParams returns a copy of the service with all mandatory
+// parameters set to the given values.
+func (s ModifyFuturesPositionMarginService) WithPositionMarginParams(
+	symbolREST, amount string, marginChangeType common.BIPositionMarginChangeType,
+) *ModifyFuturesPositionMarginService {
+	s.symbolREST = symbolREST
+	s.amount = amount
+	s.marginChangeType = strconv.Itoa(int(marginChangeType))
+	return &s
+}
+
+// WithPositionSide returns a copy of the service with positionSide set to
+// the given value.
+func (s ModifyFuturesPositionMarginService) WithPositionSide(positionSide common.BIPositionSide) *ModifyFuturesPositionMarginService {
+	positionSideStr := string(positionSide)
+	s.positionSide = &positionSideStr
+	return &s
+}
+
+/* ==================== FuturesPositionModeService ======================= */
+
+// FuturesPositionModeResponse is the response of a FuturesPositionModeService.
+type FuturesPositionModeResponse struct {
+	ServiceBaseResponse
+	DualSidePosition bool `json:"dualSidePosition"` // true: hedge mode, false: one-way mode
+}
+
+// FuturesPositionModeService gets the position mode (hedge or one-way) of
+// the futures account.
+type FuturesPositionModeService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+}
+
+// Do sends the request and returns a FuturesPositionModeResponse.
+func (s *FuturesPositionModeService) Do(ctx context.Context) (*FuturesPositionModeResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesPositionModeService) toParams() params {
+	return params{}
+}
+
+func (s *FuturesPositionModeService) parseResponse(data []byte) (*FuturesPositionModeResponse, error) {
+	resp := &FuturesPositionModeResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== ChangeFuturesPositionModeService ================= */
+
+// ChangeFuturesPositionModeService changes the position mode (hedge or
+// one-way) of the futures account on every symbol.
+type ChangeFuturesPositionModeService struct {
+	SM               common.ServiceMeta
+	rc               common.RESTClient
+	logger           *log.Entry
+	dualSidePosition string // (true: hedge mode, false: one-way mode)
+}
+
+// Do sends the request and returns a FuturesCodeResponse.
+func (s *ChangeFuturesPositionModeService) Do(ctx context.Context) (*FuturesCodeResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesCodeResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *ChangeFuturesPositionModeService) toParams() params {
+	p := params{}
+	p.Set("dualSidePosition", s.dualSidePosition)
+	return p
+}
+
+// WithDualSidePosition returns a copy of the service with dualSidePosition
+// set to the given value (true: hedge mode, false: one-way mode).
+func (s ChangeFuturesPositionModeService) WithDualSidePosition(dualSidePosition bool) *ChangeFuturesPositionModeService {
+	s.dualSidePosition = strconv.FormatBool(dualSidePosition)
+	return &s
+}
+
+/* ==================== FuturesMultiAssetsModeService ==================== */
+
+// FuturesMultiAssetsModeResponse is the response of a
+// FuturesMultiAssetsModeService.
+type FuturesMultiAssetsModeResponse struct {
+	ServiceBaseResponse
+	MultiAssetsMargin bool `json:"multiAssetsMargin"` // true: multi-assets mode, false: single-asset mode
+}
+
+// FuturesMultiAssetsModeService gets the multi-assets mode of the futures
+// account.
+type FuturesMultiAssetsModeService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+}
+
+// Do sends the request and returns a FuturesMultiAssetsModeResponse.
+func (s *FuturesMultiAssetsModeService) Do(ctx context.Context) (*FuturesMultiAssetsModeResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesMultiAssetsModeService) toParams() params {
+	return params{}
+}
+
+func (s *FuturesMultiAssetsModeService) parseResponse(data []byte) (*FuturesMultiAssetsModeResponse, error) {
+	resp := &FuturesMultiAssetsModeResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== ChangeFuturesMultiAssetsModeService ============== */
+
+// ChangeFuturesMultiAssetsModeService changes the multi-assets mode of the
+// futures account.
+type ChangeFuturesMultiAssetsModeService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	multiAssetsMargin string // (true: multi-assets mode, false: single-asset mode)
+}
+
+// Do sends the request and returns a FuturesCodeResponse.
+func (s *ChangeFuturesMultiAssetsModeService) Do(ctx context.Context) (*FuturesCodeResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesCodeResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *ChangeFuturesMultiAssetsModeService) toParams() params {
+	p := params{}
+	p.Set("multiAssetsMargin", s.multiAssetsMargin)
+	return p
+}
+
+// WithMultiAssetsMargin returns a copy of the service with multiAssetsMargin
+// set to the given value (true: multi-assets mode, false: single-asset mode).
+func (s ChangeFuturesMultiAssetsModeService) WithMultiAssetsMargin(multiAssetsMargin bool) *ChangeFuturesMultiAssetsModeService {
+	s.multiAssetsMargin = strconv.FormatBool(multiAssetsMargin)
+	return &s
+}
+
+/* ==================== FuturesLeverageBracketService ==================== */
+
+// FuturesLeverageBracket is a notional bracket of a symbol.
+type FuturesLeverageBracket struct {
+	Bracket          int     `json:"bracket"`
+	InitialLeverage  int     `json:"initialLeverage"` // max initial leverage for this bracket
+	NotionalCap      float64 `json:"notionalCap"`
+	NotionalFloor    float64 `json:"notionalFloor"`
+	MaintMarginRatio float64 `json:"maintMarginRatio"`
+	Cum              float64 `json:"cum"`
+}
+
+// FuturesSymbolLeverageBrackets holds the notional brackets of a symbol.
+type FuturesSymbolLeverageBrackets struct {
+	Symbol       string                   `json:"symbol"`
+	NotionalCoef float64                  `json:"notionalCoef"` // user's symbol bracket multiplier, only if it is not 1
+	Brackets     []FuturesLeverageBracket `json:"brackets"`
+}
+
+// FuturesLeverageBracketResponse is the response of a
+// FuturesLeverageBracketService.
+type FuturesLeverageBracketResponse struct {
+	ServiceBaseResponse
+	Symbols []FuturesSymbolLeverageBrackets
+}
+
+// FuturesLeverageBracketService gets the notional and leverage brackets of a
+// symbol, or of all symbols if no symbol is set.
+type FuturesLeverageBracketService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns a FuturesLeverageBracketResponse.
+func (s *FuturesLeverageBracketService) Do(ctx context.Context) (*FuturesLeverageBracketResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesLeverageBracketService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+// parseResponse parses data into a FuturesLeverageBracketResponse. Binance
+// returns a single object instead of a list if a symbol was sent.
+func (s *FuturesLeverageBracketService) parseResponse(data []byte) (*FuturesLeverageBracketResponse, error) {
+	resp := &FuturesLeverageBracketResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalObjectOrArray(data, &resp.Symbols); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesLeverageBracketService) WithSymbolREST(symbolREST string) *FuturesLeverageBracketService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+/* ==================== FuturesCommissionRateService ===================== */
+
+// FuturesCommissionRateResponse is the response of a
+// FuturesCommissionRateService.
+type FuturesCommissionRateResponse struct {
+	ServiceBaseResponse
+	Symbol              string `json:"symbol"`
+	MakerCommissionRate string `json:"makerCommissionRate"`
+	TakerCommissionRate string `json:"takerCommissionRate"`
+}
+
+// FuturesCommissionRateService gets the account's commission rates of a
+// symbol.
+type FuturesCommissionRateService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+}
+
+// Do sends the request and returns a FuturesCommissionRateResponse.
+func (s *FuturesCommissionRateService) Do(ctx context.Context) (*FuturesCommissionRateResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesCommissionRateService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	return p
+}
+
+func (s *FuturesCommissionRateService) parseResponse(data []byte) (*FuturesCommissionRateResponse, error) {
+	resp := &FuturesCommissionRateResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesCommissionRateService) WithSymbolREST(symbolREST string) *FuturesCommissionRateService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+/* ==================== FuturesIncomeHistoryService ====================== */
+
+// FuturesIncome is an income of a futures account.
+type FuturesIncome struct {
+	Symbol     string              `json:"symbol"` // empty if the income is not related to a symbol
+	IncomeType common.BIIncomeType `json:"incomeType"`
+	Income     string              `json:"income"`
+	Asset      string              `json:"asset"`
+	Info       string              `json:"info"`
+	TSSIncome  common.TSNano       `json:"time"`
+	TranID     int64               `json:"tranId"`
+	TradeID    string              `json:"tradeId"` // empty if the income is not related to a trade
+}
+
+// FuturesIncomeHistoryResponse is the response of a
+// FuturesIncomeHistoryService.
+type FuturesIncomeHistoryResponse struct {
+	ServiceBaseResponse
+	Incomes []FuturesIncome
+}
+
+// FuturesIncomeHistoryService gets the income history of the futures
+// account. If neither startTime nor endTime are set, the last 7 days are
+// returned.
+type FuturesIncomeHistoryService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	incomeType *string
+	startTime  *string
+	endTime    *string
+	page       *string
+	limit      *string // (default: 100, max: 1000)
+}
+
+// Do sends the request and returns a FuturesIncomeHistoryResponse.
+func (s *FuturesIncomeHistoryService) Do(ctx context.Context) (*FuturesIncomeHistoryResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesIncomeHistoryService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetIfNotNil("incomeType", s.incomeType)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("page", s.page)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *FuturesIncomeHistoryService) parseResponse(data []byte) (*FuturesIncomeHistoryResponse, error) {
+	resp := &FuturesIncomeHistoryResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Incomes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesIncomeHistoryService) WithSymbolREST(symbolREST string) *FuturesIncomeHistoryService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+// WithIncomeType returns a copy of the service with incomeType set to the
+// given value.
+func (s FuturesIncomeHistoryService) WithIncomeType(incomeType common.BIIncomeType) *FuturesIncomeHistoryService {
+	incomeTypeStr := string(incomeType)
+	s.incomeType = &incomeTypeStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s FuturesIncomeHistoryService) WithStartTime(startTime common.TSNano) *FuturesIncomeHistoryService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s FuturesIncomeHistoryService) WithEndTime(endTime common.TSNano) *FuturesIncomeHistoryService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithPage returns a copy of the service with page set to the given value.
+func (s FuturesIncomeHistoryService) WithPage(page int) *FuturesIncomeHistoryService {
+	pageStr := strconv.Itoa(page)
+	s.page = &pageStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s FuturesIncomeHistoryService) WithLimit(limit int) *FuturesIncomeHistoryService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== FuturesTradesService ============================= */
+
+// FuturesTrade is a trade of the futures account.
+type FuturesTrade struct {
+	Symbol          string                `json:"symbol"`
+	ID              int64                 `json:"id"`
+	OrderID         int64                 `json:"orderId"`
+	Side            common.BIOrderSide    `json:"side"`
+	PositionSide    common.BIPositionSide `json:"positionSide"`
+	Price           string                `json:"price"`
+	Qty             string                `json:"qty"`
+	QuoteQty        string                `json:"quoteQty"`
+	RealizedPnl     string                `json:"realizedPnl"`
+	Commission      string                `json:"commission"`
+	CommissionAsset string                `json:"commissionAsset"`
+	TSSTrade        common.TSNano         `json:"time"`
+	IsBuyer         bool                  `json:"buyer"`
+	IsMaker         bool                  `json:"maker"`
+}
+
+// FuturesTradesResponse is the response of a FuturesTradesService.
+type FuturesTradesResponse struct {
+	ServiceBaseResponse
+	Trades []FuturesTrade
+}
+
+// FuturesTradesService gets the trades of the futures account on a symbol.
+type FuturesTradesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	orderID    *string // (only with symbol)
+	startTime  *string
+	endTime    *string
+	fromID     *string // if set, trades >= fromId are returned
+	limit      *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns a FuturesTradesResponse.
+func (s *FuturesTradesService) Do(ctx context.Context) (*FuturesTradesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesTradesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("fromId", s.fromID)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *FuturesTradesService) parseResponse(data []byte) (*FuturesTradesResponse, error) {
+	resp := &FuturesTradesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Trades); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesTradesService) WithSymbolREST(symbolREST string) *FuturesTradesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s FuturesTradesService) WithOrderID(orderID int64) *FuturesTradesService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s FuturesTradesService) WithStartTime(startTime common.TSNano) *FuturesTradesService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s FuturesTradesService) WithEndTime(endTime common.TSNano) *FuturesTradesService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithFromID returns a copy of the service with fromID set to the given value.
+func (s FuturesTradesService) WithFromID(fromID int64) *FuturesTradesService {
+	fromIDStr := strconv.FormatInt(fromID, 10)
+	s.fromID = &fromIDStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s FuturesTradesService) WithLimit(limit int) *FuturesTradesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	fapiAccountV3Data = []byte(`{
+  "totalInitialMargin": "0.00000000",
+  "totalMaintMargin": "0.00000000",
+  "totalWalletBalance": "103.12345678",
+  "totalUnrealizedProfit": "0.00000000",
+  "totalMarginBalance": "103.12345678",
+  "totalPositionInitialMargin": "0.00000000",
+  "totalOpenOrderInitialMargin": "0.00000000",
+  "totalCrossWalletBalance": "103.12345678",
+  "totalCrossUnPnl": "0.00000000",
+  "availableBalance": "103.12345678",
+  "maxWithdrawAmount": "103.12345678",
+  "assets": [
+    {
+      "asset": "USDT",
+      "walletBalance": "23.72469206",
+      "unrealizedProfit": "0.00000000",
+      "marginBalance": "23.72469206",
+      "maintMargin": "0.00000000",
+      "initialMargin": "0.00000000",
+      "positionInitialMargin": "0.00000000",
+      "openOrderInitialMargin": "0.00000000",
+      "crossWalletBalance": "23.72469206",
+      "crossUnPnl": "0.00000000",
+      "availableBalance": "23.72469206",
+      "maxWithdrawAmount": "23.72469206",
+      "updateTime": 1625474304765
+    }
+  ],
+  "positions": [
+    {
+      "symbol": "RLCUSDT",
+      "positionSide": "BOTH",
+      "positionAmt": "1.00",
+      "unrealizedProfit": "0.00246488",
+      "isolatedMargin": "0",
+      "notional": "1.61117588",
+      "isolatedWallet": "0",
+      "initialMargin": "0.32223517",
+      "maintMargin": "0.01288940",
+      "updateTime": 0
+    }
+  ]
+}`)
+
+	fapiLeverageBracketData = []byte(`{
+  "symbol": "ETHUSDT",
+  "notionalCoef": 1.50,
+  "brackets": [
+    {"bracket": 1, "initialLeverage": 75, "notionalCap": 10000, "notionalFloor": 0, "maintMarginRatio": 0.0065, "cum": 0}
+  ]
+}`)
+
+	fapiIncomeHistoryData = []byte(`[
+  {
+    "symbol": "",
+    "incomeType": "TRANSFER",
+    "income": "-0.37500000",
+    "asset": "USDT",
+    "info": "TRANSFER",
+    "time": 1570608000000,
+    "tranId": 9689322392,
+    "tradeId": ""
+  },
+  {
+    "symbol": "BTCUSDT",
+    "incomeType": "COMMISSION",
+    "income": "-0.01000000",
+    "asset": "USDT",
+    "info": "COMMISSION",
+    "time": 1570636800000,
+    "tranId": 9689322392,
+    "tradeId": "2059192"
+  }
+]`)
+)
+
+func TestFuturesAccountService(t *testing.T) {
+	service := NewFuturesAccountV3Service(nil, log.NewEntry(log.New()))
+	assert.Equal(t, "/fapi/v3/account", service.SM.SD.Path)
+	assert.Equal(t, "/fapi/v2/account", NewFuturesAccountV2Service(nil, log.NewEntry(log.New())).SM.SD.Path)
+
+	resp, err := service.parseResponse(fapiAccountV3Data)
+	assert.Nil(t, err)
+	assert.Equal(t, "103.12345678", resp.TotalWalletBalance)
+	assert.Len(t, resp.Assets, 1)
+	assert.Equal(t, "23.72469206", resp.Assets[0].AvailableBalance)
+	assert.Equal(t, common.NewTSNano(1625474304765), resp.Assets[0].TSSUpdate)
+	assert.Len(t, resp.Positions, 1)
+	assert.Equal(t, common.PositionSideBoth, resp.Positions[0].PositionSide)
+	assert.Equal(t, "0.01288940", resp.Positions[0].MaintMargin)
+}
+
+func TestModifyFuturesPositionMarginService(t *testing.T) {
+	service := NewModifyFuturesPositionMarginService(nil, log.NewEntry(log.New())).
+		WithPositionMarginParams("BTCUSDT", "100", common.PositionMarginChangeTypeReduce).
+		WithPositionSide(common.PositionSideLong)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "amount": "100", "type": "2", "positionSide": "LONG"}, service.toParams())
+
+	resp, err := service.parseResponse([]byte(`{"amount": 100.0, "code": 200, "msg": "Successfully modify position margin.", "type": 2}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "100.0", resp.Amount.String())
+	assert.Equal(t, common.PositionMarginChangeTypeReduce, resp.MarginChangeType)
+}
+
+func TestChangeFuturesModeServices(t *testing.T) {
+	marginType := NewChangeFuturesMarginTypeService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithMarginType(common.FuturesMarginTypeIsolated)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "marginType": "ISOLATED"}, marginType.toParams())
+
+	positionMode := NewChangeFuturesPositionModeService(nil, log.NewEntry(log.New())).WithDualSidePosition(true)
+	assert.Equal(t, params{"dualSidePosition": "true"}, positionMode.toParams())
+
+	multiAssetsMode := NewChangeFuturesMultiAssetsModeService(nil, log.NewEntry(log.New())).WithMultiAssetsMargin(false)
+	assert.Equal(t, params{"multiAssetsMargin": "false"}, multiAssetsMode.toParams())
+}
+
+func TestFuturesLeverageBracketService(t *testing.T) {
+	service := NewFuturesLeverageBracketService(nil, log.NewEntry(log.New())).WithSymbolREST("ETHUSDT")
+
+	// a single object is returned if a symbol was sent
+	resp, err := service.parseResponse(fapiLeverageBracketData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Symbols, 1)
+	assert.Equal(t, "ETHUSDT", resp.Symbols[0].Symbol)
+	assert.Equal(t, 75, resp.Symbols[0].Brackets[0].InitialLeverage)
+	assert.Equal(t, 0.0065, resp.Symbols[0].Brackets[0].MaintMarginRatio)
+
+	resp, err = service.parseResponse([]byte(`[` + string(fapiLeverageBracketData) + `]`))
+	assert.Nil(t, err)
+	assert.Len(t, resp.Symbols, 1)
+}
+
+func TestFuturesIncomeHistoryService(t *testing.T) {
+	service := NewFuturesIncomeHistoryService(nil, log.NewEntry(log.New())).
+		WithIncomeType(common.IncomeTypeCommission).
+		WithStartTime(common.NewTSNano(1570608000000)).
+		WithLimit(1000)
+	assert.Equal(t, params{"incomeType": "COMMISSION", "startTime": "1570608000000", "limit": "1000"}, service.toParams())
+
+	resp, err := service.parseResponse(fapiIncomeHistoryData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Incomes, 2)
+	assert.Equal(t, common.IncomeTypeTransfer, resp.Incomes[0].IncomeType)
+	assert.Equal(t, "", resp.Incomes[0].TradeID)
+	assert.Equal(t, "2059192", resp.Incomes[1].TradeID)
+	assert.Equal(t, int64(9689322392), resp.Incomes[1].TranID)
+}
@@ -0,0 +1,381 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== FuturesPremiumIndexService ======================= */
+
+// FuturesPremiumIndex is the mark price and funding rate of a symbol.
+type FuturesPremiumIndex struct {
+	Symbol               string        `json:"symbol"`
+	MarkPrice            string        `json:"markPrice"`
+	IndexPrice           string        `json:"indexPrice"`
+	EstimatedSettlePrice string        `json:"estimatedSettlePrice"` // only useful in the last hour before settlement
+	LastFundingRate      string        `json:"lastFundingRate"`
+	InterestRate         string        `json:"interestRate"`
+	TSSNextFunding       common.TSNano `json:"nextFundingTime"`
+	TSSTime              common.TSNano `json:"time"`
+}
+
+// FuturesPremiumIndexResponse is the response of a
+// FuturesPremiumIndexService.
+type FuturesPremiumIndexResponse struct {
+	ServiceBaseResponse
+	PremiumIndexes []FuturesPremiumIndex
+}
+
+// FuturesPremiumIndexService gets the mark price and funding rate of a
+// symbol, or of all symbols.
+type FuturesPremiumIndexService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns a FuturesPremiumIndexResponse.
+func (s *FuturesPremiumIndexService) Do(ctx context.Context) (*FuturesPremiumIndexResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesPremiumIndexService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+func (s *FuturesPremiumIndexService) parseResponse(data []byte) (*FuturesPremiumIndexResponse, error) {
+	resp := &FuturesPremiumIndexResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalObjectOrArray(data, &resp.PremiumIndexes); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 10
+// to 1.
+func (s FuturesPremiumIndexService) WithSymbolREST(symbolREST string) *FuturesPremiumIndexService {
+	s.symbolREST = &symbolREST
+	s.SM.SD.WeightIP = 1
+	return &s
+}
+
+/* ==================== FuturesFundingRateService ======================== */
+
+// FuturesFundingRate is a past funding rate of a symbol.
+type FuturesFundingRate struct {
+	Symbol      string        `json:"symbol"`
+	FundingRate string        `json:"fundingRate"`
+	TSSFunding  common.TSNano `json:"fundingTime"`
+	MarkPrice   string        `json:"markPrice"`
+}
+
+// FuturesFundingRateResponse is the response of a FuturesFundingRateService.
+type FuturesFundingRateResponse struct {
+	ServiceBaseResponse
+	FundingRates []FuturesFundingRate
+}
+
+// FuturesFundingRateService gets the funding rate history of a symbol, or
+// of all symbols. Results are in ascending order.
+type FuturesFundingRateService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	startTime  *string
+	endTime    *string
+	limit      *string // (default: 100, max: 1000)
+}
+
+// Do sends the request and returns a FuturesFundingRateResponse.
+func (s *FuturesFundingRateService) Do(ctx context.Context) (*FuturesFundingRateResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesFundingRateService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *FuturesFundingRateService) parseResponse(data []byte) (*FuturesFundingRateResponse, error) {
+	resp := &FuturesFundingRateResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.FundingRates); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesFundingRateService) WithSymbolREST(symbolREST string) *FuturesFundingRateService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s FuturesFundingRateService) WithStartTime(startTime common.TSNano) *FuturesFundingRateService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s FuturesFundingRateService) WithEndTime(endTime common.TSNano) *FuturesFundingRateService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s FuturesFundingRateService) WithLimit(limit int) *FuturesFundingRateService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== FuturesOpenInterestService ======================= */
+
+// FuturesOpenInterestResponse is the response of a
+// FuturesOpenInterestService.
+type FuturesOpenInterestResponse struct {
+	ServiceBaseResponse
+	Symbol       string        `json:"symbol"`
+	OpenInterest string        `json:"openInterest"`
+	TSSTime      common.TSNano `json:"time"`
+}
+
+// FuturesOpenInterestService gets the present open interest of a symbol.
+type FuturesOpenInterestService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+}
+
+// Do sends the request and returns a FuturesOpenInterestResponse.
+func (s *FuturesOpenInterestService) Do(ctx context.Context) (*FuturesOpenInterestResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesOpenInterestService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	return p
+}
+
+func (s *FuturesOpenInterestService) parseResponse(data []byte) (*FuturesOpenInterestResponse, error) {
+	resp := &FuturesOpenInterestResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesOpenInterestService) WithSymbolREST(symbolREST string) *FuturesOpenInterestService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+/* ==================== FuturesStatsService ============================== */
+
+// FuturesStatsService is a generic service for the futures trading
+// statistics endpoints (/futures/data/...). Only data of the latest 30 days
+// is available.
+type FuturesStatsService[T any] struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	period     common.BIFuturesStatsPeriod
+	startTime  *string
+	endTime    *string
+	limit      *string // (default: 30, max: 500)
+}
+
+// FuturesStatsResponse is the response of a FuturesStatsService.
+type FuturesStatsResponse[T any] struct {
+	ServiceBaseResponse
+	Stats []T
+}
+
+// Do sends the request and returns a FuturesStatsResponse.
+func (s *FuturesStatsService[T]) Do(ctx context.Context) (*FuturesStatsResponse[T], error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesStatsService[T]) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("period", string(s.period))
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *FuturesStatsService[T]) parseResponse(data []byte) (*FuturesStatsResponse[T], error) {
+	resp := &FuturesStatsResponse[T]{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Stats); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s FuturesStatsService[T]) WithSymbolREST(symbolREST string) *FuturesStatsService[T] {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithPeriod returns a copy of the service with period set to the given value.
+func (s FuturesStatsService[T]) WithPeriod(period common.BIFuturesStatsPeriod) *FuturesStatsService[T] {
+	s.period = period
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s FuturesStatsService[T]) WithStartTime(startTime common.TSNano) *FuturesStatsService[T] {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s FuturesStatsService[T]) WithEndTime(endTime common.TSNano) *FuturesStatsService[T] {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s FuturesStatsService[T]) WithLimit(limit int) *FuturesStatsService[T] {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== Futures Stats ==================================== */
+
+// FuturesOpenInterestStat is the open interest of a symbol in a period.
+type FuturesOpenInterestStat struct {
+	Symbol               string        `json:"symbol"`
+	SumOpenInterest      string        `json:"sumOpenInterest"`
+	SumOpenInterestValue string        `json:"sumOpenInterestValue"`
+	CMCCirculatingSupply string        `json:"CMCCirculatingSupply"`
+	TSSTime              common.TSNano `json:"timestamp"`
+}
+
+// FuturesOpenInterestHistService gets the open interest history of a symbol.
+type FuturesOpenInterestHistService = FuturesStatsService[FuturesOpenInterestStat]
+
+// FuturesLongShortRatioStat is the long/short ratio of a symbol in a period.
+// For the top trader position ratio, LongAccount and ShortAccount are the
+// ratios of long and short positions.
+type FuturesLongShortRatioStat struct {
+	Symbol         string        `json:"symbol"`
+	LongShortRatio string        `json:"longShortRatio"`
+	LongAccount    string        `json:"longAccount"`
+	ShortAccount   string        `json:"shortAccount"`
+	TSSTime        common.TSNano `json:"timestamp"`
+}
+
+// FuturesLongShortRatioService gets the top trader account, top trader
+// position, or global account long/short ratio history of a symbol.
+type FuturesLongShortRatioService = FuturesStatsService[FuturesLongShortRatioStat]
+
+// FuturesTakerLongShortRatioStat is the taker buy/sell volume of a symbol
+// in a period.
+type FuturesTakerLongShortRatioStat struct {
+	BuySellRatio string        `json:"buySellRatio"`
+	BuyVol       string        `json:"buyVol"`
+	SellVol      string        `json:"sellVol"`
+	TSSTime      common.TSNano `json:"timestamp"`
+}
+
+// FuturesTakerLongShortRatioService gets the taker buy/sell volume history
+// of a symbol.
+type FuturesTakerLongShortRatioService = FuturesStatsService[FuturesTakerLongShortRatioStat]
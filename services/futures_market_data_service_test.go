@@ -0,0 +1,82 @@
+package services
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	fapiPremiumIndexData = []byte(`[
+  {
+    "symbol": "BTCUSDT",
+    "markPrice": "11793.63104562",
+    "indexPrice": "11781.80495970",
+    "estimatedSettlePrice": "11781.16138815",
+    "lastFundingRate": "0.00038246",
+    "interestRate": "0.00010000",
+    "nextFundingTime": 1597392000000,
+    "time": 1597370495002
+  }
+]`)
+
+	fapiFundingRateData = []byte(`[
+  {"symbol": "BTCUSDT", "fundingRate": "-0.03750000", "fundingTime": 1570608000000, "markPrice": "34287.54619963"},
+  {"symbol": "BTCUSDT", "fundingRate": "0.00010000", "fundingTime": 1570636800000, "markPrice": "34287.54619963"}
+]`)
+
+	fapiTopLongShortPositionRatioData = []byte(`[
+  {
+    "symbol": "BTCUSDT",
+    "longShortRatio": "1.4342",
+    "longAccount": "0.5891",
+    "shortAccount": "0.4108",
+    "timestamp": "1583139600000"
+  }
+]`)
+)
+
+func TestFuturesPremiumIndexService(t *testing.T) {
+	service := NewFuturesPremiumIndexService(nil, log.NewEntry(log.New()))
+	assert.Equal(t, params{}, service.toParams())
+	assert.Equal(t, 10, service.SM.SD.WeightIP)
+	assert.Equal(t, 1, service.WithSymbolREST("BTCUSDT").SM.SD.WeightIP)
+
+	resp, err := service.parseResponse(fapiPremiumIndexData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.PremiumIndexes, 1)
+	assert.Equal(t, "0.00038246", resp.PremiumIndexes[0].LastFundingRate)
+	assert.Equal(t, common.NewTSNano(1597392000000), resp.PremiumIndexes[0].TSSNextFunding)
+}
+
+func TestFuturesFundingRateService(t *testing.T) {
+	service := NewFuturesFundingRateService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithStartTime(common.NewTSNano(1570608000000)).
+		WithLimit(2)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "startTime": "1570608000000", "limit": "2"}, service.toParams())
+
+	resp, err := service.parseResponse(fapiFundingRateData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.FundingRates, 2)
+	assert.Equal(t, "-0.03750000", resp.FundingRates[0].FundingRate)
+	assert.Equal(t, common.NewTSNano(1570636800000), resp.FundingRates[1].TSSFunding)
+}
+
+func TestFuturesLongShortRatioService(t *testing.T) {
+	service := NewFuturesTopLongShortPositionRatioService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithPeriod(common.FuturesStatsPeriod5m).
+		WithLimit(1)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "period": "5m", "limit": "1"}, service.toParams())
+	assert.Equal(t, "/futures/data/topLongShortPositionRatio", service.SM.SD.Path)
+
+	resp, err := service.parseResponse(fapiTopLongShortPositionRatioData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Stats, 1)
+	assert.Equal(t, "1.4342", resp.Stats[0].LongShortRatio)
+	assert.Equal(t, "0.4108", resp.Stats[0].ShortAccount)
+	assert.Equal(t, common.NewTSNano(1583139600000), resp.Stats[0].TSSTime)
+}
@@ -0,0 +1,845 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== FuturesOrder ===================================== */
+
+// FuturesOrder is an order as returned by all futures order endpoints.
+type FuturesOrder struct {
+	Symbol                  string                           `json:"symbol"`
+	OrderID                 int64                            `json:"orderId"`
+	ClientOrderID           string                           `json:"clientOrderId"`
+	Price                   string                           `json:"price"`
+	AvgPrice                string                           `json:"avgPrice"`
+	StopPrice               string                           `json:"stopPrice"`     // (STOP, STOP_MARKET, TAKE_PROFIT, TAKE_PROFIT_MARKET)
+	ActivatePrice           string                           `json:"activatePrice"` // (TRAILING_STOP_MARKET)
+	PriceRate               string                           `json:"priceRate"`     // (TRAILING_STOP_MARKET)
+	OrigQty                 string                           `json:"origQty"`
+	ExecutedQty             string                           `json:"executedQty"`
+	CumQty                  string                           `json:"cumQty"`
+	CumQuote                string                           `json:"cumQuote"`
+	Status                  common.BIOrderStatus             `json:"status"`
+	TimeInForce             common.BIOrderTimeInForce        `json:"timeInForce"`
+	OrderType               common.BIOrderType               `json:"type"`
+	OrigOrderType           common.BIOrderType               `json:"origType"`
+	Side                    common.BIOrderSide               `json:"side"`
+	PositionSide            common.BIPositionSide            `json:"positionSide"`
+	WorkingType             common.BIWorkingType             `json:"workingType"`
+	IsReduceOnly            bool                             `json:"reduceOnly"`
+	IsClosePosition         bool                             `json:"closePosition"`
+	PriceProtect            bool                             `json:"priceProtect"`
+	PriceMatch              string                           `json:"priceMatch"`
+	SelfTradePreventionMode common.BISelfTradePreventionMode `json:"selfTradePreventionMode"`
+	TSSGoodTill             common.TSNano                    `json:"goodTillDate"` // only for GTD orders
+	TSSUpdate               common.TSNano                    `json:"updateTime"`
+}
+
+// FuturesOrderResponse is the response of all futures order services that
+// return a single order.
+type FuturesOrderResponse struct {
+	ServiceBaseResponse
+	FuturesOrder
+}
+
+// parseFuturesOrderResponse parses data into a FuturesOrderResponse.
+func parseFuturesOrderResponse(sm *common.ServiceMeta, data []byte) (*FuturesOrderResponse, error) {
+	resp := &FuturesOrderResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FuturesCodeResponse is the response of futures services that return
+// nothing but a status code and message (e.g. cancel all orders or change
+// margin type).
+type FuturesCodeResponse struct {
+	ServiceBaseResponse
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// parseFuturesCodeResponse parses data into a FuturesCodeResponse.
+func parseFuturesCodeResponse(sm *common.ServiceMeta, data []byte) (*FuturesCodeResponse, error) {
+	resp := &FuturesCodeResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== CreateFuturesOrderService ======================== */
+
+// CreateFuturesOrderService creates a new futures order.
+type CreateFuturesOrderService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string  // (ALL ORDERS)
+	side                    string  // (ALL ORDERS)
+	orderType               string  // (ALL ORDERS)
+	positionSide            *string // (ALL ORDERS) (BOTH, LONG, SHORT) (default: BOTH, LONG or SHORT in hedge mode)
+	timeInForce             *string // (LIMIT, STOP, TAKE_PROFIT)
+	quantity                *string // (not with closePosition)
+	price                   *string // (LIMIT, STOP, TAKE_PROFIT)
+	stopPrice               *string // (STOP, STOP_MARKET, TAKE_PROFIT, TAKE_PROFIT_MARKET)
+	activationPrice         *string // (TRAILING_STOP_MARKET)
+	callbackRate            *string // (TRAILING_STOP_MARKET) (min: 0.1, max: 10)
+	reduceOnly              *string // (true, false) (not in hedge mode or with closePosition)
+	closePosition           *string // (STOP_MARKET, TAKE_PROFIT_MARKET) (true, false)
+	workingType             *string // (MARK_PRICE, CONTRACT_PRICE) (default: CONTRACT_PRICE)
+	priceProtect            *string // (STOP, STOP_MARKET, TAKE_PROFIT, TAKE_PROFIT_MARKET) (TRUE, FALSE)
+	priceMatch              *string // (LIMIT, STOP, TAKE_PROFIT) (not with price)
+	selfTradePreventionMode *string // (EXPIRE_TAKER, EXPIRE_MAKER, EXPIRE_BOTH, NONE)
+	goodTillDate            *string // (GTD) (milliseconds)
+	newClientOrderID        *string // (ALL ORDERS)
+	newOrderRespType        *string // (ACK, RESULT) (default: ACK)
+}
+
+// Do sends the request and returns a FuturesOrderResponse.
+func (s *CreateFuturesOrderService) Do(ctx context.Context) (*FuturesOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesOrderResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateFuturesOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("side", s.side)
+	p.Set("type", s.orderType)
+	p.SetIfNotNil("positionSide", s.positionSide)
+	p.SetIfNotNil("timeInForce", s.timeInForce)
+
+	p.SetIfNotNil("quantity", s.quantity)
+	p.SetIfNotNil("price", s.price)
+	p.SetIfNotNil("stopPrice", s.stopPrice)
+	p.SetIfNotNil("activationPrice", s.activationPrice)
+	p.SetIfNotNil("callbackRate", s.callbackRate)
+
+	p.SetIfNotNil("reduceOnly", s.reduceOnly)
+	p.SetIfNotNil("closePosition", s.closePosition)
+	p.SetIfNotNil("workingType", s.workingType)
+	p.SetIfNotNil("priceProtect", s.priceProtect)
+	p.SetIfNotNil("priceMatch", s.priceMatch)
+
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	p.SetIfNotNil("goodTillDate", s.goodTillDate)
+	p.SetIfNotNil("newClientOrderId", s.newClientOrderID)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	return p
+}
+
+// WithBaseOrderParams returns a copy of the service with all parameters that
+// are mandatory and shared between all order types set.
+func (s CreateFuturesOrderService) WithBaseOrderParams(
+	symbolREST string, side common.BIOrderSide, orderType common.BIOrderType,
+) *CreateFuturesOrderService {
+	return s.WithSymbolREST(symbolREST).WithSide(side).WithOrderType(orderType)
+}
+
+// WithMarketOrderParams returns a copy of the service with all parameters that
+// are mandatory for a market order set.
+// Any optional params must be set before or after calling this method.
+func (s CreateFuturesOrderService) WithMarketOrderParams(
+	symbolREST string, side common.BIOrderSide, quantity string,
+) *CreateFuturesOrderService {
+	return s.
+		WithBaseOrderParams(symbolREST, side, common.OrderTypeMarket).
+		WithQuantity(quantity)
+}
+
+// WithLimitOrderParams returns a copy of the service with all parameters that
+// are mandatory for a limit order set.
+// Any optional params must be set before or after calling this method.
+func (s CreateFuturesOrderService) WithLimitOrderParams(
+	symbolREST string,
+	side common.BIOrderSide,
+	quantity,
+	price string,
+	timeInForce common.BIOrderTimeInForce,
+) *CreateFuturesOrderService {
+	return s.
+		WithBaseOrderParams(symbolREST, side, common.OrderTypeLimit).
+		WithQuantity(quantity).
+		WithPrice(price).
+		WithTimeInForce(timeInForce)
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateFuturesOrderService) WithSymbolREST(symbolREST string) *CreateFuturesOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithSide returns a copy of the service with side set to the given value.
+func (s CreateFuturesOrderService) WithSide(side common.BIOrderSide) *CreateFuturesOrderService {
+	s.side = string(side)
+	return &s
+}
+
+// WithOrderType returns a copy of the service with orderType set to the given value.
+func (s CreateFuturesOrderService) WithOrderType(orderType common.BIOrderType) *CreateFuturesOrderService {
+	s.orderType = string(orderType)
+	return &s
+}
+
+// WithPositionSide returns a copy of the service with positionSide set to
+// the given value.
+func (s CreateFuturesOrderService) WithPositionSide(positionSide common.BIPositionSide) *CreateFuturesOrderService {
+	positionSideStr := string(positionSide)
+	s.positionSide = &positionSideStr
+	return &s
+}
+
+// WithTimeInForce returns a copy of the service with timeInForce set to the
+// given value. Use WithGoodTillDate for GTD orders.
+func (s CreateFuturesOrderService) WithTimeInForce(timeInForce common.BIOrderTimeInForce) *CreateFuturesOrderService {
+	timeInForceStr := string(timeInForce)
+	s.timeInForce = &timeInForceStr
+	return &s
+}
+
+// WithGoodTillDate returns a copy of the service with timeInForce set to GTD
+// and goodTillDate set to the given value. goodTillDate must be at least 600
+// seconds in the future.
+func (s CreateFuturesOrderService) WithGoodTillDate(goodTillDate common.TSNano) *CreateFuturesOrderService {
+	goodTillDateStr := tsNanoToMilliStr(goodTillDate)
+	s.goodTillDate = &goodTillDateStr
+	return s.WithTimeInForce(common.OrderTimeInForceGTD)
+}
+
+// WithQuantity returns a copy of the service with quantity set to the given value.
+func (s CreateFuturesOrderService) WithQuantity(quantity string) *CreateFuturesOrderService {
+	s.quantity = &quantity
+	return &s
+}
+
+// WithPrice returns a copy of the service with price set to the given value.
+func (s CreateFuturesOrderService) WithPrice(price string) *CreateFuturesOrderService {
+	s.price = &price
+	return &s
+}
+
+// WithStopPrice returns a copy of the service with stopPrice set to the given value.
+func (s CreateFuturesOrderService) WithStopPrice(stopPrice string) *CreateFuturesOrderService {
+	s.stopPrice = &stopPrice
+	return &s
+}
+
+// WithActivationPrice returns a copy of the service with activationPrice set
+// to the given value.
+func (s CreateFuturesOrderService) WithActivationPrice(activationPrice string) *CreateFuturesOrderService {
+	s.activationPrice = &activationPrice
+	return &s
+}
+
+// WithCallbackRate returns a copy of the service with callbackRate set to
+// the given value.
+func (s CreateFuturesOrderService) WithCallbackRate(callbackRate string) *CreateFuturesOrderService {
+	s.callbackRate = &callbackRate
+	return &s
+}
+
+// WithReduceOnly returns a copy of the service with reduceOnly set to the
+// given value.
+func (s CreateFuturesOrderService) WithReduceOnly(reduceOnly bool) *CreateFuturesOrderService {
+	reduceOnlyStr := strconv.FormatBool(reduceOnly)
+	s.reduceOnly = &reduceOnlyStr
+	return &s
+}
+
+// WithClosePosition returns a copy of the service with closePosition set to
+// the given value.
+func (s CreateFuturesOrderService) WithClosePosition(closePosition bool) *CreateFuturesOrderService {
+	closePositionStr := strconv.FormatBool(closePosition)
+	s.closePosition = &closePositionStr
+	return &s
+}
+
+// WithWorkingType returns a copy of the service with workingType set to the
+// given value.
+func (s CreateFuturesOrderService) WithWorkingType(workingType common.BIWorkingType) *CreateFuturesOrderService {
+	workingTypeStr := string(workingType)
+	s.workingType = &workingTypeStr
+	return &s
+}
+
+// WithPriceProtect returns a copy of the service with priceProtect set to
+// the given value.
+func (s CreateFuturesOrderService) WithPriceProtect(priceProtect bool) *CreateFuturesOrderService {
+	priceProtectStr := boolToUpperStr(priceProtect)
+	s.priceProtect = &priceProtectStr
+	return &s
+}
+
+// WithPriceMatch returns a copy of the service with priceMatch set to the
+// given value (OPPONENT, OPPONENT_5, OPPONENT_10, OPPONENT_20, QUEUE,
+// QUEUE_5, QUEUE_10, QUEUE_20).
+func (s CreateFuturesOrderService) WithPriceMatch(priceMatch string) *CreateFuturesOrderService {
+	s.priceMatch = &priceMatch
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with
+// selfTradePreventionMode set to the given value.
+func (s CreateFuturesOrderService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateFuturesOrderService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+// WithNewClientOrderID returns a copy of the service with newClientOrderID
+// set to the given value.
+func (s CreateFuturesOrderService) WithNewClientOrderID(newClientOrderID string) *CreateFuturesOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateFuturesOrderService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateFuturesOrderService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+/* ==================== CreateFuturesBatchOrdersService ================== */
+
+// FuturesBatchOrderResult is the result of a single order of a batch.
+// Code and Msg are only set if the order failed.
+type FuturesBatchOrderResult struct {
+	FuturesOrder
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// CreateFuturesBatchOrdersResponse is the response of a
+// CreateFuturesBatchOrdersService. Results are in the same order as the
+// orders of the batch.
+type CreateFuturesBatchOrdersResponse struct {
+	ServiceBaseResponse
+	Results []FuturesBatchOrderResult
+}
+
+// maxFuturesBatchOrders is the maximum number of orders of a batch.
+const maxFuturesBatchOrders = 5
+
+// CreateFuturesBatchOrdersService creates up to 5 futures orders at once.
+// The orders are set with WithOrders and are processed concurrently.
+// Do returns an error without sending the request if the batch is empty or
+// has more than 5 orders.
+type CreateFuturesBatchOrdersService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+	orders []*CreateFuturesOrderService // (max 5)
+}
+
+// Do sends the request and returns a CreateFuturesBatchOrdersResponse.
+// Orders of the batch may fail individually, check each result's Code.
+func (s *CreateFuturesBatchOrdersService) Do(ctx context.Context) (*CreateFuturesBatchOrdersResponse, error) {
+	params, err := s.toParams()
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+// The orders are encoded as a json list in batchOrders.
+func (s *CreateFuturesBatchOrdersService) toParams() (params, error) {
+	if len(s.orders) == 0 || len(s.orders) > maxFuturesBatchOrders {
+		return nil, fmt.Errorf("batch must have 1 to %d orders, got %d", maxFuturesBatchOrders, len(s.orders))
+	}
+
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order.toParams())
+	}
+
+	batchOrders, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := params{}
+	p.Set("batchOrders", string(batchOrders))
+	return p, nil
+}
+
+func (s *CreateFuturesBatchOrdersService) parseResponse(data []byte) (*CreateFuturesBatchOrdersResponse, error) {
+	resp := &CreateFuturesBatchOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Results); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithOrders returns a copy of the service with the batch set to the given
+// CreateFuturesOrderServices' parameters.
+func (s CreateFuturesBatchOrdersService) WithOrders(orders ...*CreateFuturesOrderService) *CreateFuturesBatchOrdersService {
+	s.orders = orders
+	return &s
+}
+
+/* ==================== ModifyFuturesOrderService ======================== */
+
+// ModifyFuturesOrderService modifies the price and quantity of an open
+// futures LIMIT order. (symbolREST, side, quantity, price or priceMatch, and
+// either orderId or origClientOrderId must be sent)
+type ModifyFuturesOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	side              string
+	quantity          string
+	price             *string
+	priceMatch        *string
+	orderID           *string
+	origClientOrderID *string
+}
+
+// Do sends the request and returns a FuturesOrderResponse.
+func (s *ModifyFuturesOrderService) Do(ctx context.Context) (*FuturesOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesOrderResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *ModifyFuturesOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("side", s.side)
+	p.Set("quantity", s.quantity)
+	p.SetIfNotNil("price", s.price)
+	p.SetIfNotNil("priceMatch", s.priceMatch)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s ModifyFuturesOrderService) WithSymbolREST(symbolREST string) *ModifyFuturesOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithSide returns a copy of the service with side set to the given value.
+func (s ModifyFuturesOrderService) WithSide(side common.BIOrderSide) *ModifyFuturesOrderService {
+	s.side = string(side)
+	return &s
+}
+
+// WithQuantity returns a copy of the service with quantity set to the given value.
+func (s ModifyFuturesOrderService) WithQuantity(quantity string) *ModifyFuturesOrderService {
+	s.quantity = quantity
+	return &s
+}
+
+// WithPrice returns a copy of the service with price set to the given value.
+func (s ModifyFuturesOrderService) WithPrice(price string) *ModifyFuturesOrderService {
+	s.price = &price
+	return &s
+}
+
+// WithPriceMatch returns a copy of the service with priceMatch set to the
+// given value.
+func (s ModifyFuturesOrderService) WithPriceMatch(priceMatch string) *ModifyFuturesOrderService {
+	s.priceMatch = &priceMatch
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s ModifyFuturesOrderService) WithOrderID(orderID int64) *ModifyFuturesOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s ModifyFuturesOrderService) WithOrigClientOrderID(origClientOrderID string) *ModifyFuturesOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+/* ==================== CancelFuturesOrderService ======================== */
+
+// CancelFuturesOrderService cancels an active futures order.
+// (symbolREST and either orderId or origClientOrderId must be sent)
+type CancelFuturesOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	orderID           *string
+	origClientOrderID *string
+}
+
+// Do sends the request and returns a FuturesOrderResponse.
+func (s *CancelFuturesOrderService) Do(ctx context.Context) (*FuturesOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesOrderResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelFuturesOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelFuturesOrderService) WithSymbolREST(symbolREST string) *CancelFuturesOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s CancelFuturesOrderService) WithOrderID(orderID int64) *CancelFuturesOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s CancelFuturesOrderService) WithOrigClientOrderID(origClientOrderID string) *CancelFuturesOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+/* ==================== CancelAllFuturesOrdersService ==================== */
+
+// CancelAllFuturesOrdersService cancels all open futures orders on a symbol.
+type CancelAllFuturesOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+}
+
+// Do sends the request and returns a FuturesCodeResponse.
+func (s *CancelAllFuturesOrdersService) Do(ctx context.Context) (*FuturesCodeResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseFuturesCodeResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelAllFuturesOrdersService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelAllFuturesOrdersService) WithSymbolREST(symbolREST string) *CancelAllFuturesOrdersService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+/* ==================== CountdownCancelAllFuturesService ================= */
+
+// CountdownCancelAllFuturesResponse is the response of a
+// CountdownCancelAllFuturesService.
+type CountdownCancelAllFuturesResponse struct {
+	ServiceBaseResponse
+	Symbol        string `json:"symbol"`
+	CountdownTime string `json:"countdownTime"` // milliseconds
+}
+
+// CountdownCancelAllFuturesService sets (or resets) a countdown after which
+// all open futures orders on a symbol are canceled (dead man's switch).
+// The service should be called repeatedly as a heartbeat, a countdownTime of
+// 0 cancels the countdown.
+type CountdownCancelAllFuturesService struct {
+	SM            common.ServiceMeta
+	rc            common.RESTClient
+	logger        *log.Entry
+	symbolREST    string
+	countdownTime string // milliseconds
+}
+
+// Do sends the request and returns a CountdownCancelAllFuturesResponse.
+func (s *CountdownCancelAllFuturesService) Do(ctx context.Context) (*CountdownCancelAllFuturesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CountdownCancelAllFuturesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("countdownTime", s.countdownTime)
+	return p
+}
+
+func (s *CountdownCancelAllFuturesService) parseResponse(data []byte) (*CountdownCancelAllFuturesResponse, error) {
+	resp := &CountdownCancelAllFuturesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CountdownCancelAllFuturesService) WithSymbolREST(symbolREST string) *CountdownCancelAllFuturesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithCountdownTime returns a copy of the service with countdownTime set to
+// the given value (0 to cancel the countdown).
+func (s CountdownCancelAllFuturesService) WithCountdownTime(countdownTime time.Duration) *CountdownCancelAllFuturesService {
+	s.countdownTime = strconv.FormatInt(countdownTime.Milliseconds(), 10)
+	return &s
+}
+
+/* ==================== QueryFuturesOrderService ========================= */
+
+// FuturesOrderDetails is a futures order as returned by the query order and
+// open orders endpoints.
+type FuturesOrderDetails struct {
+	FuturesOrder
+	TSSCreated common.TSNano `json:"time"`
+}
+
+// QueryFuturesOrderResponse is the response of a QueryFuturesOrderService.
+type QueryFuturesOrderResponse struct {
+	ServiceBaseResponse
+	FuturesOrderDetails
+}
+
+// QueryFuturesOrderService checks a futures order's status.
+// (symbolREST and either orderId or origClientOrderId must be sent)
+type QueryFuturesOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	orderID           *string
+	origClientOrderID *string
+}
+
+// Do sends the request and returns a QueryFuturesOrderResponse.
+func (s *QueryFuturesOrderService) Do(ctx context.Context) (*QueryFuturesOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *QueryFuturesOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	return p
+}
+
+func (s *QueryFuturesOrderService) parseResponse(data []byte) (*QueryFuturesOrderResponse, error) {
+	resp := &QueryFuturesOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s QueryFuturesOrderService) WithSymbolREST(symbolREST string) *QueryFuturesOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s QueryFuturesOrderService) WithOrderID(orderID int64) *QueryFuturesOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s QueryFuturesOrderService) WithOrigClientOrderID(origClientOrderID string) *QueryFuturesOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+/* ==================== OpenFuturesOrdersService ========================= */
+
+// OpenFuturesOrdersResponse is the response of an OpenFuturesOrdersService.
+type OpenFuturesOrdersResponse struct {
+	ServiceBaseResponse
+	Orders []FuturesOrderDetails
+}
+
+// OpenFuturesOrdersService gets all open futures orders on a symbol, or on
+// all symbols if no symbol is set.
+type OpenFuturesOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns an OpenFuturesOrdersResponse.
+func (s *OpenFuturesOrdersService) Do(ctx context.Context) (*OpenFuturesOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *OpenFuturesOrdersService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+func (s *OpenFuturesOrdersService) parseResponse(data []byte) (*OpenFuturesOrdersResponse, error) {
+	resp := &OpenFuturesOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Orders); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 40
+// to 1.
+func (s OpenFuturesOrdersService) WithSymbolREST(symbolREST string) *OpenFuturesOrdersService {
+	s.symbolREST = &symbolREST
+	s.SM.SD.WeightIP = 1
+	return &s
+}
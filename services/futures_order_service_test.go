@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	fapiCreateOrderData = []byte(`{
+  "clientOrderId": "testOrder",
+  "cumQty": "0",
+  "cumQuote": "0",
+  "executedQty": "0",
+  "orderId": 22542179,
+  "avgPrice": "0.00000",
+  "origQty": "10",
+  "price": "0",
+  "reduceOnly": false,
+  "side": "BUY",
+  "positionSide": "SHORT",
+  "status": "NEW",
+  "stopPrice": "9300",
+  "closePosition": false,
+  "symbol": "BTCUSDT",
+  "timeInForce": "GTD",
+  "type": "TRAILING_STOP_MARKET",
+  "origType": "TRAILING_STOP_MARKET",
+  "activatePrice": "9020",
+  "priceRate": "0.3",
+  "updateTime": 1566818724722,
+  "workingType": "CONTRACT_PRICE",
+  "priceProtect": false,
+  "priceMatch": "NONE",
+  "selfTradePreventionMode": "NONE",
+  "goodTillDate": 1693207680000
+}`)
+
+	fapiBatchOrdersData = []byte(`[
+  {
+    "clientOrderId": "testOrder1",
+    "orderId": 22542180,
+    "symbol": "BTCUSDT",
+    "status": "NEW",
+    "type": "LIMIT",
+    "side": "BUY",
+    "price": "30000",
+    "origQty": "0.01"
+  },
+  {
+    "code": -2022,
+    "msg": "ReduceOnly Order is rejected."
+  }
+]`)
+)
+
+func TestCreateFuturesOrderService(t *testing.T) {
+	service := NewCreateFuturesOrderService(nil, log.NewEntry(log.New())).
+		WithLimitOrderParams("BTCUSDT", common.OrderSideBuy, "0.01", "30000", common.OrderTimeInForceGTC).
+		WithPositionSide(common.PositionSideLong).
+		WithReduceOnly(false).
+		WithPriceProtect(true).
+		WithGoodTillDate(common.NewTSNano(1693207680000))
+
+	assert.Equal(t, params{
+		"symbol": "BTCUSDT", "side": "BUY", "type": "LIMIT", "quantity": "0.01", "price": "30000",
+		"positionSide": "LONG", "reduceOnly": "false", "priceProtect": "TRUE",
+		"timeInForce": "GTD", "goodTillDate": "1693207680000",
+	}, service.toParams())
+
+	resp, err := parseFuturesOrderResponse(&service.SM, fapiCreateOrderData)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(22542179), resp.OrderID)
+	assert.Equal(t, common.PositionSideShort, resp.PositionSide)
+	assert.Equal(t, common.OrderTypeTrailingStopMarket, resp.OrigOrderType)
+	assert.Equal(t, common.WorkingTypeContractPrice, resp.WorkingType)
+	assert.Equal(t, common.OrderTimeInForceGTD, resp.TimeInForce)
+	assert.Equal(t, common.NewTSNano(1693207680000), resp.TSSGoodTill)
+}
+
+func TestCreateFuturesBatchOrdersService(t *testing.T) {
+	order1 := (&CreateFuturesOrderService{}).WithLimitOrderParams("BTCUSDT", common.OrderSideBuy, "0.01", "30000", common.OrderTimeInForceGTC)
+	order2 := (&CreateFuturesOrderService{}).WithMarketOrderParams("BTCUSDT", common.OrderSideSell, "0.01").WithReduceOnly(true)
+	service := NewCreateFuturesBatchOrdersService(nil, log.NewEntry(log.New())).
+		WithOrders(order1, order2)
+
+	p, err := service.toParams()
+	assert.Nil(t, err)
+
+	batchOrders := []params{}
+	assert.Nil(t, json.Unmarshal([]byte(p["batchOrders"]), &batchOrders))
+	assert.Equal(t, []params{order1.toParams(), order2.toParams()}, batchOrders)
+
+	// orders of a batch fail individually
+	resp, err := service.parseResponse(fapiBatchOrdersData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Results, 2)
+	assert.Equal(t, 0, resp.Results[0].Code)
+	assert.Equal(t, int64(22542180), resp.Results[0].OrderID)
+	assert.Equal(t, -2022, resp.Results[1].Code)
+	assert.Equal(t, "ReduceOnly Order is rejected.", resp.Results[1].Msg)
+
+	// empty batches and batches of more than 5 orders are not sent
+	rc := &mockPagesRestClient{}
+	_, err = NewCreateFuturesBatchOrdersService(rc, log.NewEntry(log.New())).Do(context.Background())
+	assert.Error(t, err)
+	_, err = NewCreateFuturesBatchOrdersService(rc, log.NewEntry(log.New())).
+		WithOrders(order1, order1, order1, order1, order1, order1).
+		Do(context.Background())
+	assert.Error(t, err)
+	assert.Len(t, rc.params, 0)
+}
+
+func TestCountdownCancelAllFuturesService(t *testing.T) {
+	service := NewCountdownCancelAllFuturesService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithCountdownTime(2 * time.Minute)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "countdownTime": "120000"}, service.toParams())
+
+	resp, err := service.parseResponse([]byte(`{"symbol": "BTCUSDT", "countdownTime": "120000"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "120000", resp.CountdownTime)
+}
+
+func TestOpenFuturesOrdersService(t *testing.T) {
+	service := NewOpenFuturesOrdersService(nil, log.NewEntry(log.New()))
+	assert.Equal(t, 40, service.SM.SD.WeightIP)
+
+	// querying a single symbol has a lower weight
+	service = service.WithSymbolREST("BTCUSDT")
+	assert.Equal(t, 1, service.SM.SD.WeightIP)
+	assert.Equal(t, 40, FAPIServices["openOrders"].WeightIP)
+}
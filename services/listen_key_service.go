@@ -21,11 +21,23 @@ type CreateListenKeyService struct {
 	SM     common.ServiceMeta
 	rc     common.RESTClient
 	logger *log.Entry
+	symbol string
+}
+
+// WithSymbol returns a copy of the service with symbol set to the provided
+// value (ISOLATED MARGIN only).
+func (s CreateListenKeyService) WithSymbol(symbol string) *CreateListenKeyService {
+	s.symbol = symbol
+	return &s
 }
 
 // toParams converts all parameter fields of the service to a params struct.
 func (s *CreateListenKeyService) toParams() *params {
-	return &params{}
+	p := &params{}
+	if s.symbol != "" {
+		p.Set("symbol", s.symbol)
+	}
+	return p
 }
 
 // parseResponse parses the request response into the CreateListenKeyResponse struct.
@@ -63,6 +75,7 @@ func (s *CreateListenKeyService) Do(ctx context.Context) (*CreateListenKeyRespon
 // PingListenKeyResponse
 type PingListenKeyResponse struct {
 	ServiceBaseResponse
+	ListenKey string `json:"listenKey"` // (FAPI)
 }
 
 // PingListenKeyService
@@ -71,6 +84,7 @@ type PingListenKeyService struct {
 	rc        common.RESTClient
 	logger    *log.Entry
 	listenKey string
+	symbol    string
 }
 
 // WithListenKey returns a copy of the service with listenKey set to the
@@ -80,11 +94,18 @@ func (s PingListenKeyService) WithListenKey(listenKey string) *PingListenKeyServ
 	return &s
 }
 
+// WithSymbol returns a copy of the service with symbol set to the provided
+// value (ISOLATED MARGIN only).
+func (s PingListenKeyService) WithSymbol(symbol string) *PingListenKeyService {
+	s.symbol = symbol
+	return &s
+}
+
 // toParams converts all parameter fields of the service to a params struct.
+// FAPI listen keys are bound to the account, so listenKey is never sent to
+// the FAPI.
 func (s *PingListenKeyService) toParams() *params {
-	p := &params{}
-	p.Set("listenKey", s.listenKey)
-	return p
+	return listenKeyParams(&s.SM, s.listenKey, s.symbol)
 }
 
 // parseResponse parses the request response into the PingListenKeyResponse struct.
@@ -93,6 +114,11 @@ func (s *PingListenKeyService) parseResponse(data []byte) (*PingListenKeyRespons
 	if err := resp.ParseBaseResponse(&s.SM); err != nil {
 		return nil, err
 	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
@@ -124,6 +150,7 @@ type CloseListenKeyService struct {
 	rc        common.RESTClient
 	logger    *log.Entry
 	listenKey string
+	symbol    string
 }
 
 // WithListenKey returns a copy of the service with listenKey set to the
@@ -133,10 +160,31 @@ func (s CloseListenKeyService) WithListenKey(listenKey string) *CloseListenKeySe
 	return &s
 }
 
+// WithSymbol returns a copy of the service with symbol set to the provided
+// value (ISOLATED MARGIN only).
+func (s CloseListenKeyService) WithSymbol(symbol string) *CloseListenKeyService {
+	s.symbol = symbol
+	return &s
+}
+
 // toParams converts all parameter fields of the service to a params struct.
+// FAPI listen keys are bound to the account, so listenKey is never sent to
+// the FAPI.
 func (s *CloseListenKeyService) toParams() *params {
+	return listenKeyParams(&s.SM, s.listenKey, s.symbol)
+}
+
+/* ==================== helpers ========================================== */
+
+// listenKeyParams returns the params for ping and close listen key requests.
+func listenKeyParams(sm *common.ServiceMeta, listenKey, symbol string) *params {
 	p := &params{}
-	p.Set("listenKey", s.listenKey)
+	if sm.SD.EndpointType != common.EndpointTypeFAPI {
+		p.Set("listenKey", listenKey)
+	}
+	if symbol != "" {
+		p.Set("symbol", symbol)
+	}
 	return p
 }
 
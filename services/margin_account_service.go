@@ -0,0 +1,728 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== MarginBorrowRepayService ========================= */
+
+// MarginTransactionResponse is the response of services that return
+// nothing but a transaction id (e.g. borrow, repay and transfer).
+type MarginTransactionResponse struct {
+	ServiceBaseResponse
+	TranID int64 `json:"tranId"`
+}
+
+// parseMarginTransactionResponse parses data into a MarginTransactionResponse.
+func parseMarginTransactionResponse(sm *common.ServiceMeta, data []byte) (*MarginTransactionResponse, error) {
+	resp := &MarginTransactionResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MarginBorrowRepayService borrows or repays an asset on a cross or isolated
+// margin account. (asset, amount and borrowRepayType must be sent, symbolREST
+// is mandatory for isolated margin)
+type MarginBorrowRepayService struct {
+	SM              common.ServiceMeta
+	rc              common.RESTClient
+	logger          *log.Entry
+	asset           string
+	amount          string
+	borrowRepayType string  // (BORROW, REPAY)
+	isIsolated      *string // (default: FALSE)
+	symbolREST      *string // (isolated margin only)
+}
+
+// Do sends the request and returns a MarginTransactionResponse.
+func (s *MarginBorrowRepayService) Do(ctx context.Context) (*MarginTransactionResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseMarginTransactionResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginBorrowRepayService) toParams() params {
+	p := params{}
+	p.Set("asset", s.asset)
+	p.Set("amount", s.amount)
+	p.Set("type", s.borrowRepayType)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+// WithBorrowRepayParams returns a copy of the service with all mandatory
+// parameters set to the given values.
+func (s MarginBorrowRepayService) WithBorrowRepayParams(asset, amount string, borrowRepayType common.BIMarginBorrowRepayType) *MarginBorrowRepayService {
+	s.asset = asset
+	s.amount = amount
+	s.borrowRepayType = string(borrowRepayType)
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s MarginBorrowRepayService) WithIsIsolated(isIsolated bool) *MarginBorrowRepayService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s MarginBorrowRepayService) WithSymbolREST(symbolREST string) *MarginBorrowRepayService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+/* ==================== MarginMaxBorrowableService ======================= */
+
+// MarginMaxBorrowableResponse is the response of a MarginMaxBorrowableService.
+type MarginMaxBorrowableResponse struct {
+	ServiceBaseResponse
+	Amount      string `json:"amount"`      // max borrowable amount, limited by the account's collateral
+	BorrowLimit string `json:"borrowLimit"` // max borrowable amount, limited by the account's vip level
+}
+
+// MarginMaxBorrowableService gets the max amount of an asset that can be
+// borrowed on a cross or isolated margin account.
+type MarginMaxBorrowableService struct {
+	SM             common.ServiceMeta
+	rc             common.RESTClient
+	logger         *log.Entry
+	asset          string
+	isolatedSymbol *string // (isolated margin only)
+}
+
+// Do sends the request and returns a MarginMaxBorrowableResponse.
+func (s *MarginMaxBorrowableService) Do(ctx context.Context) (*MarginMaxBorrowableResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginMaxBorrowableService) toParams() params {
+	p := params{}
+	p.Set("asset", s.asset)
+	p.SetIfNotNil("isolatedSymbol", s.isolatedSymbol)
+	return p
+}
+
+func (s *MarginMaxBorrowableService) parseResponse(data []byte) (*MarginMaxBorrowableResponse, error) {
+	resp := &MarginMaxBorrowableResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithAsset returns a copy of the service with asset set to the given value.
+func (s MarginMaxBorrowableService) WithAsset(asset string) *MarginMaxBorrowableService {
+	s.asset = asset
+	return &s
+}
+
+// WithIsolatedSymbol returns a copy of the service with isolatedSymbol set
+// to the given value.
+func (s MarginMaxBorrowableService) WithIsolatedSymbol(isolatedSymbol string) *MarginMaxBorrowableService {
+	s.isolatedSymbol = &isolatedSymbol
+	return &s
+}
+
+/* ==================== MarginMaxTransferableService ===================== */
+
+// MarginMaxTransferableResponse is the response of a
+// MarginMaxTransferableService.
+type MarginMaxTransferableResponse struct {
+	ServiceBaseResponse
+	Amount string `json:"amount"`
+}
+
+// MarginMaxTransferableService gets the max amount of an asset that can be
+// transferred out of a cross or isolated margin account.
+type MarginMaxTransferableService struct {
+	SM             common.ServiceMeta
+	rc             common.RESTClient
+	logger         *log.Entry
+	asset          string
+	isolatedSymbol *string // (isolated margin only)
+}
+
+// Do sends the request and returns a MarginMaxTransferableResponse.
+func (s *MarginMaxTransferableService) Do(ctx context.Context) (*MarginMaxTransferableResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginMaxTransferableService) toParams() params {
+	p := params{}
+	p.Set("asset", s.asset)
+	p.SetIfNotNil("isolatedSymbol", s.isolatedSymbol)
+	return p
+}
+
+func (s *MarginMaxTransferableService) parseResponse(data []byte) (*MarginMaxTransferableResponse, error) {
+	resp := &MarginMaxTransferableResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithAsset returns a copy of the service with asset set to the given value.
+func (s MarginMaxTransferableService) WithAsset(asset string) *MarginMaxTransferableService {
+	s.asset = asset
+	return &s
+}
+
+// WithIsolatedSymbol returns a copy of the service with isolatedSymbol set
+// to the given value.
+func (s MarginMaxTransferableService) WithIsolatedSymbol(isolatedSymbol string) *MarginMaxTransferableService {
+	s.isolatedSymbol = &isolatedSymbol
+	return &s
+}
+
+/* ==================== MarginAccountService ============================= */
+
+// MarginUserAsset is an asset of a cross margin account.
+type MarginUserAsset struct {
+	Asset    string `json:"asset"`
+	Borrowed string `json:"borrowed"`
+	Free     string `json:"free"`
+	Interest string `json:"interest"`
+	Locked   string `json:"locked"`
+	NetAsset string `json:"netAsset"`
+}
+
+// MarginAccountResponse is the response of a MarginAccountService.
+type MarginAccountResponse struct {
+	ServiceBaseResponse
+	Created                    bool              `json:"created"`
+	BorrowEnabled              bool              `json:"borrowEnabled"`
+	TradeEnabled               bool              `json:"tradeEnabled"`
+	TransferInEnabled          bool              `json:"transferInEnabled"`
+	TransferOutEnabled         bool              `json:"transferOutEnabled"`
+	AccountType                string            `json:"accountType"` // (MARGIN_1, MARGIN_2)
+	MarginLevel                string            `json:"marginLevel"`
+	CollateralMarginLevel      string            `json:"collateralMarginLevel"`
+	TotalAssetOfBTC            string            `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBTC        string            `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBTC         string            `json:"totalNetAssetOfBtc"`
+	TotalCollateralValueInUSDT string            `json:"TotalCollateralValueInUSDT"`
+	TotalOpenOrderLossInUSDT   string            `json:"totalOpenOrderLossInUSDT"`
+	UserAssets                 []MarginUserAsset `json:"userAssets"`
+}
+
+// MarginAccountService gets the details of the cross margin account.
+type MarginAccountService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+}
+
+// Do sends the request and returns a MarginAccountResponse.
+func (s *MarginAccountService) Do(ctx context.Context) (*MarginAccountResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginAccountService) toParams() params {
+	return params{}
+}
+
+func (s *MarginAccountService) parseResponse(data []byte) (*MarginAccountResponse, error) {
+	resp := &MarginAccountResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== IsolatedMarginAccountService ===================== */
+
+// IsolatedMarginAsset is the base or quote asset of an isolated margin
+// account.
+type IsolatedMarginAsset struct {
+	Asset         string `json:"asset"`
+	BorrowEnabled bool   `json:"borrowEnabled"`
+	RepayEnabled  bool   `json:"repayEnabled"`
+	Borrowed      string `json:"borrowed"`
+	Free          string `json:"free"`
+	Interest      string `json:"interest"`
+	Locked        string `json:"locked"`
+	NetAsset      string `json:"netAsset"`
+	NetAssetOfBTC string `json:"netAssetOfBtc"`
+	TotalAsset    string `json:"totalAsset"`
+}
+
+// IsolatedMarginAccount is the isolated margin account of a symbol.
+type IsolatedMarginAccount struct {
+	Symbol            string                     `json:"symbol"`
+	BaseAsset         IsolatedMarginAsset        `json:"baseAsset"`
+	QuoteAsset        IsolatedMarginAsset        `json:"quoteAsset"`
+	IsolatedCreated   bool                       `json:"isolatedCreated"`
+	Enabled           bool                       `json:"enabled"` // true if the account is enabled, false if disabled
+	TradeEnabled      bool                       `json:"tradeEnabled"`
+	MarginLevel       string                     `json:"marginLevel"`
+	MarginLevelStatus common.BIMarginLevelStatus `json:"marginLevelStatus"`
+	MarginRatio       string                     `json:"marginRatio"`
+	IndexPrice        string                     `json:"indexPrice"`
+	LiquidatePrice    string                     `json:"liquidatePrice"`
+	LiquidateRate     string                     `json:"liquidateRate"`
+}
+
+// IsolatedMarginAccountResponse is the response of an
+// IsolatedMarginAccountService. The totals are only returned if no symbols
+// were requested.
+type IsolatedMarginAccountResponse struct {
+	ServiceBaseResponse
+	Assets              []IsolatedMarginAccount `json:"assets"`
+	TotalAssetOfBTC     string                  `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBTC string                  `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBTC  string                  `json:"totalNetAssetOfBtc"`
+}
+
+// IsolatedMarginAccountService gets the details of all isolated margin
+// accounts, or of the isolated margin accounts of up to 5 symbols.
+type IsolatedMarginAccountService struct {
+	SM      common.ServiceMeta
+	rc      common.RESTClient
+	logger  *log.Entry
+	symbols *string // (max 5, comma separated)
+}
+
+// Do sends the request and returns an IsolatedMarginAccountResponse.
+func (s *IsolatedMarginAccountService) Do(ctx context.Context) (*IsolatedMarginAccountResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *IsolatedMarginAccountService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbols", s.symbols)
+	return p
+}
+
+func (s *IsolatedMarginAccountService) parseResponse(data []byte) (*IsolatedMarginAccountResponse, error) {
+	resp := &IsolatedMarginAccountResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbols returns a copy of the service with symbols set to the given
+// values (max 5).
+func (s IsolatedMarginAccountService) WithSymbols(symbols ...string) *IsolatedMarginAccountService {
+	symbolsStr := strings.Join(symbols, ",")
+	s.symbols = &symbolsStr
+	return &s
+}
+
+/* ==================== MarginInterestHistoryService ===================== */
+
+// MarginInterest is an interest charge of a margin account.
+type MarginInterest struct {
+	TxID               int64         `json:"txId"`
+	TSSInterestAccured common.TSNano `json:"interestAccuredTime"`
+	Asset              string        `json:"asset"`
+	RawAsset           string        `json:"rawAsset"` // not returned for isolated margin
+	Principal          string        `json:"principal"`
+	Interest           string        `json:"interest"`
+	InterestRate       string        `json:"interestRate"`
+	InterestType       string        `json:"type"`           // (PERIODIC, ON_BORROW, PERIODIC_CONVERTED, ON_BORROW_CONVERTED, PORTFOLIO)
+	IsolatedSymbol     string        `json:"isolatedSymbol"` // isolated margin only
+}
+
+// MarginInterestHistoryResponse is the response of a
+// MarginInterestHistoryService.
+type MarginInterestHistoryResponse struct {
+	ServiceBaseResponse
+	Rows  []MarginInterest `json:"rows"`
+	Total int              `json:"total"`
+}
+
+// MarginInterestHistoryService gets the interest history of the cross or an
+// isolated margin account.
+type MarginInterestHistoryService struct {
+	SM             common.ServiceMeta
+	rc             common.RESTClient
+	logger         *log.Entry
+	asset          *string
+	isolatedSymbol *string // (isolated margin only)
+	startTime      *string
+	endTime        *string
+	current        *string // current page (default: 1)
+	size           *string // (default: 10, max: 100)
+}
+
+// Do sends the request and returns a MarginInterestHistoryResponse.
+func (s *MarginInterestHistoryService) Do(ctx context.Context) (*MarginInterestHistoryResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginInterestHistoryService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("asset", s.asset)
+	p.SetIfNotNil("isolatedSymbol", s.isolatedSymbol)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("current", s.current)
+	p.SetIfNotNil("size", s.size)
+	return p
+}
+
+func (s *MarginInterestHistoryService) parseResponse(data []byte) (*MarginInterestHistoryResponse, error) {
+	resp := &MarginInterestHistoryResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithAsset returns a copy of the service with asset set to the given value.
+func (s MarginInterestHistoryService) WithAsset(asset string) *MarginInterestHistoryService {
+	s.asset = &asset
+	return &s
+}
+
+// WithIsolatedSymbol returns a copy of the service with isolatedSymbol set
+// to the given value.
+func (s MarginInterestHistoryService) WithIsolatedSymbol(isolatedSymbol string) *MarginInterestHistoryService {
+	s.isolatedSymbol = &isolatedSymbol
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s MarginInterestHistoryService) WithStartTime(startTime common.TSNano) *MarginInterestHistoryService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s MarginInterestHistoryService) WithEndTime(endTime common.TSNano) *MarginInterestHistoryService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithCurrent returns a copy of the service with current set to the given value.
+func (s MarginInterestHistoryService) WithCurrent(current int) *MarginInterestHistoryService {
+	currentStr := strconv.Itoa(current)
+	s.current = &currentStr
+	return &s
+}
+
+// WithSize returns a copy of the service with size set to the given value.
+func (s MarginInterestHistoryService) WithSize(size int) *MarginInterestHistoryService {
+	sizeStr := strconv.Itoa(size)
+	s.size = &sizeStr
+	return &s
+}
+
+/* ==================== MarginTransferService ============================ */
+
+// MarginTransferService transfers an asset between the spot account and the
+// cross or an isolated margin account. (transferType, asset and amount must
+// be sent, fromSymbol and toSymbol are mandatory when transferring from or
+// to an isolated margin account)
+type MarginTransferService struct {
+	SM           common.ServiceMeta
+	rc           common.RESTClient
+	logger       *log.Entry
+	transferType string
+	asset        string
+	amount       string
+	fromSymbol   *string
+	toSymbol     *string
+}
+
+// Do sends the request and returns a MarginTransactionResponse.
+func (s *MarginTransferService) Do(ctx context.Context) (*MarginTransactionResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseMarginTransactionResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginTransferService) toParams() params {
+	p := params{}
+	p.Set("type", s.transferType)
+	p.Set("asset", s.asset)
+	p.Set("amount", s.amount)
+	p.SetIfNotNil("fromSymbol", s.fromSymbol)
+	p.SetIfNotNil("toSymbol", s.toSymbol)
+	return p
+}
+
+// WithTransferParams returns a copy of the service with all mandatory
+// parameters set to the given values.
+func (s MarginTransferService) WithTransferParams(transferType common.BIMarginTransferType, asset, amount string) *MarginTransferService {
+	s.transferType = string(transferType)
+	s.asset = asset
+	s.amount = amount
+	return &s
+}
+
+// WithFromSymbol returns a copy of the service with fromSymbol set to the
+// given value.
+func (s MarginTransferService) WithFromSymbol(fromSymbol string) *MarginTransferService {
+	s.fromSymbol = &fromSymbol
+	return &s
+}
+
+// WithToSymbol returns a copy of the service with toSymbol set to the given
+// value.
+func (s MarginTransferService) WithToSymbol(toSymbol string) *MarginTransferService {
+	s.toSymbol = &toSymbol
+	return &s
+}
+
+/* ==================== MarginTransferHistoryService ===================== */
+
+// MarginTransfer is a transfer into or out of a margin account.
+type MarginTransfer struct {
+	TxID        int64                            `json:"txId"`
+	TSSTransfer common.TSNano                    `json:"timestamp"`
+	Asset       string                           `json:"asset"`
+	Amount      string                           `json:"amount"`
+	Status      string                           `json:"status"` // (PENDING, CONFIRMED, FAILED)
+	Direction   common.BIMarginTransferDirection `json:"type"`
+	TransFrom   string                           `json:"transFrom"` // (SPOT, ISOLATED_MARGIN)
+	TransTo     string                           `json:"transTo"`   // (SPOT, ISOLATED_MARGIN)
+	FromSymbol  string                           `json:"fromSymbol"`
+	ToSymbol    string                           `json:"toSymbol"`
+}
+
+// MarginTransferHistoryResponse is the response of a
+// MarginTransferHistoryService.
+type MarginTransferHistoryResponse struct {
+	ServiceBaseResponse
+	Rows  []MarginTransfer `json:"rows"`
+	Total int              `json:"total"`
+}
+
+// MarginTransferHistoryService gets the transfer history of the cross or an
+// isolated margin account.
+type MarginTransferHistoryService struct {
+	SM             common.ServiceMeta
+	rc             common.RESTClient
+	logger         *log.Entry
+	asset          *string
+	direction      *string // (ROLL_IN, ROLL_OUT)
+	isolatedSymbol *string // (isolated margin only)
+	startTime      *string
+	endTime        *string
+	current        *string // current page (default: 1)
+	size           *string // (default: 10, max: 100)
+}
+
+// Do sends the request and returns a MarginTransferHistoryResponse.
+func (s *MarginTransferHistoryService) Do(ctx context.Context) (*MarginTransferHistoryResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginTransferHistoryService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("asset", s.asset)
+	p.SetIfNotNil("type", s.direction)
+	p.SetIfNotNil("isolatedSymbol", s.isolatedSymbol)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("current", s.current)
+	p.SetIfNotNil("size", s.size)
+	return p
+}
+
+func (s *MarginTransferHistoryService) parseResponse(data []byte) (*MarginTransferHistoryResponse, error) {
+	resp := &MarginTransferHistoryResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithAsset returns a copy of the service with asset set to the given value.
+func (s MarginTransferHistoryService) WithAsset(asset string) *MarginTransferHistoryService {
+	s.asset = &asset
+	return &s
+}
+
+// WithDirection returns a copy of the service with direction set to the
+// given value.
+func (s MarginTransferHistoryService) WithDirection(direction common.BIMarginTransferDirection) *MarginTransferHistoryService {
+	directionStr := string(direction)
+	s.direction = &directionStr
+	return &s
+}
+
+// WithIsolatedSymbol returns a copy of the service with isolatedSymbol set
+// to the given value.
+func (s MarginTransferHistoryService) WithIsolatedSymbol(isolatedSymbol string) *MarginTransferHistoryService {
+	s.isolatedSymbol = &isolatedSymbol
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s MarginTransferHistoryService) WithStartTime(startTime common.TSNano) *MarginTransferHistoryService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s MarginTransferHistoryService) WithEndTime(endTime common.TSNano) *MarginTransferHistoryService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithCurrent returns a copy of the service with current set to the given value.
+func (s MarginTransferHistoryService) WithCurrent(current int) *MarginTransferHistoryService {
+	currentStr := strconv.Itoa(current)
+	s.current = &currentStr
+	return &s
+}
+
+// WithSize returns a copy of the service with size set to the given value.
+func (s MarginTransferHistoryService) WithSize(size int) *MarginTransferHistoryService {
+	sizeStr := strconv.Itoa(size)
+	s.size = &sizeStr
+	return &s
+}
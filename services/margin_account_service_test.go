@@ -0,0 +1,137 @@
+package services
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	sapiMarginAccountData = []byte(`{
+  "created": true,
+  "borrowEnabled": true,
+  "marginLevel": "11.64405625",
+  "collateralMarginLevel": "3.2",
+  "totalAssetOfBtc": "6.82728457",
+  "totalLiabilityOfBtc": "0.58633215",
+  "totalNetAssetOfBtc": "6.24095242",
+  "TotalCollateralValueInUSDT": "5.82728457",
+  "totalOpenOrderLossInUSDT": "582.728457",
+  "tradeEnabled": true,
+  "transferInEnabled": true,
+  "transferOutEnabled": true,
+  "accountType": "MARGIN_1",
+  "userAssets": [
+    {"asset": "BTC", "borrowed": "0.00000000", "free": "0.00499500", "interest": "0.00000000", "locked": "0.00000000", "netAsset": "0.00499500"},
+    {"asset": "BNB", "borrowed": "201.66666672", "free": "2346.50000000", "interest": "0.00000000", "locked": "0.00000000", "netAsset": "2144.83333328"}
+  ]
+}`)
+
+	sapiIsolatedMarginAccountData = []byte(`{
+  "assets": [
+    {
+      "baseAsset": {
+        "asset": "BTC", "borrowEnabled": true, "borrowed": "0.00000000", "free": "0.00000000", "interest": "0.00000000",
+        "locked": "0.00000000", "netAsset": "0.00000000", "netAssetOfBtc": "0.00000000", "repayEnabled": true, "totalAsset": "0.00000000"
+      },
+      "quoteAsset": {
+        "asset": "USDT", "borrowEnabled": true, "borrowed": "0.00000000", "free": "0.00000000", "interest": "0.00000000",
+        "locked": "0.00000000", "netAsset": "0.00000000", "netAssetOfBtc": "0.00000000", "repayEnabled": true, "totalAsset": "0.00000000"
+      },
+      "symbol": "BTCUSDT",
+      "isolatedCreated": true,
+      "enabled": true,
+      "marginLevel": "0.00000000",
+      "marginLevelStatus": "EXCESSIVE",
+      "marginRatio": "0.00000000",
+      "indexPrice": "10000.00000000",
+      "liquidatePrice": "1000.00000000",
+      "liquidateRate": "1.00000000",
+      "tradeEnabled": true
+    }
+  ],
+  "totalAssetOfBtc": "0.00000000",
+  "totalLiabilityOfBtc": "0.00000000",
+  "totalNetAssetOfBtc": "0.00000000"
+}`)
+
+	sapiMarginTransferHistoryData = []byte(`{
+  "rows": [
+    {
+      "amount": "0.10000000",
+      "asset": "BNB",
+      "status": "CONFIRMED",
+      "timestamp": 1566898617,
+      "txId": 5240372201,
+      "type": "ROLL_IN",
+      "transFrom": "SPOT",
+      "transTo": "ISOLATED_MARGIN",
+      "fromSymbol": "",
+      "toSymbol": "BNBUSDT"
+    }
+  ],
+  "total": 1
+}`)
+)
+
+func TestMarginBorrowRepayService(t *testing.T) {
+	service := NewMarginBorrowRepayService(nil, log.NewEntry(log.New())).
+		WithBorrowRepayParams("BTC", "0.1", common.MarginBorrowRepayTypeBorrow).
+		WithIsIsolated(true).
+		WithSymbolREST("BTCUSDT")
+	assert.Equal(t, params{"asset": "BTC", "amount": "0.1", "type": "BORROW", "isIsolated": "TRUE", "symbol": "BTCUSDT"}, service.toParams())
+
+	resp, err := parseMarginTransactionResponse(&service.SM, []byte(`{"tranId": 100000001}`))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100000001), resp.TranID)
+}
+
+func TestMarginAccountService(t *testing.T) {
+	service := NewMarginAccountService(nil, log.NewEntry(log.New()))
+
+	resp, err := service.parseResponse(sapiMarginAccountData)
+	assert.Nil(t, err)
+	assert.Equal(t, "11.64405625", resp.MarginLevel)
+	assert.Equal(t, "5.82728457", resp.TotalCollateralValueInUSDT)
+	assert.Len(t, resp.UserAssets, 2)
+	assert.Equal(t, "201.66666672", resp.UserAssets[1].Borrowed)
+	assert.Equal(t, "2144.83333328", resp.UserAssets[1].NetAsset)
+}
+
+func TestIsolatedMarginAccountService(t *testing.T) {
+	service := NewIsolatedMarginAccountService(nil, log.NewEntry(log.New())).
+		WithSymbols("BTCUSDT", "BNBUSDT")
+	assert.Equal(t, params{"symbols": "BTCUSDT,BNBUSDT"}, service.toParams())
+
+	resp, err := service.parseResponse(sapiIsolatedMarginAccountData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Assets, 1)
+	assert.Equal(t, "BTCUSDT", resp.Assets[0].Symbol)
+	assert.Equal(t, "USDT", resp.Assets[0].QuoteAsset.Asset)
+	assert.Equal(t, common.MarginLevelStatusExcessive, resp.Assets[0].MarginLevelStatus)
+	assert.Equal(t, "1000.00000000", resp.Assets[0].LiquidatePrice)
+}
+
+func TestMarginTransferService(t *testing.T) {
+	service := NewMarginTransferService(nil, log.NewEntry(log.New())).
+		WithTransferParams(common.MarginTransferTypeMainIsolatedMargin, "USDT", "100").
+		WithToSymbol("BTCUSDT")
+	assert.Equal(t, params{"type": "MAIN_ISOLATED_MARGIN", "asset": "USDT", "amount": "100", "toSymbol": "BTCUSDT"}, service.toParams())
+}
+
+func TestMarginTransferHistoryService(t *testing.T) {
+	service := NewMarginTransferHistoryService(nil, log.NewEntry(log.New())).
+		WithDirection(common.MarginTransferDirectionRollIn).
+		WithIsolatedSymbol("BNBUSDT").
+		WithSize(100)
+	assert.Equal(t, params{"type": "ROLL_IN", "isolatedSymbol": "BNBUSDT", "size": "100"}, service.toParams())
+
+	resp, err := service.parseResponse(sapiMarginTransferHistoryData)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, resp.Total)
+	assert.Equal(t, int64(5240372201), resp.Rows[0].TxID)
+	assert.Equal(t, common.MarginTransferDirectionRollIn, resp.Rows[0].Direction)
+	assert.Equal(t, "BNBUSDT", resp.Rows[0].ToSymbol)
+}
@@ -0,0 +1,692 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== MarginOrder ====================================== */
+
+// MarginOrder holds the order fields that are shared between all margin
+// order responses.
+type MarginOrder struct {
+	Symbol                  string                           `json:"symbol"`
+	IsIsolated              bool                             `json:"isIsolated"`
+	OrderID                 int64                            `json:"orderId"`
+	ClientOrderID           string                           `json:"clientOrderId"`
+	Price                   string                           `json:"price"`
+	OrigQty                 string                           `json:"origQty"`
+	ExecutedQty             string                           `json:"executedQty"`
+	CumQuoteQty             string                           `json:"cummulativeQuoteQty"`
+	Status                  common.BIOrderStatus             `json:"status"`
+	TimeInForce             common.BIOrderTimeInForce        `json:"timeInForce"`
+	OrderType               common.BIOrderType               `json:"type"`
+	Side                    common.BIOrderSide               `json:"side"`
+	SelfTradePreventionMode common.BISelfTradePreventionMode `json:"selfTradePreventionMode"`
+}
+
+// MarginCanceledOrder is a canceled margin order.
+type MarginCanceledOrder struct {
+	MarginOrder
+	OrigClientOrderID string `json:"origClientOrderId"`
+}
+
+// MarginOrderDetails is an order as returned by the query order, open orders
+// and all orders endpoints.
+type MarginOrderDetails struct {
+	MarginOrder
+	StopPrice  string        `json:"stopPrice"`
+	IcebergQty string        `json:"icebergQty"`
+	TSSCreated common.TSNano `json:"time"`
+	TSSUpdate  common.TSNano `json:"updateTime"`
+	IsWorking  bool          `json:"isWorking"`
+}
+
+// MarginOrdersResponse is the response of an OpenMarginOrdersService and an
+// AllMarginOrdersService.
+type MarginOrdersResponse struct {
+	ServiceBaseResponse
+	Orders []MarginOrderDetails
+}
+
+// parseMarginOrdersResponse parses data into a MarginOrdersResponse.
+func parseMarginOrdersResponse(sm *common.ServiceMeta, data []byte) (*MarginOrdersResponse, error) {
+	resp := &MarginOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Orders); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== CancelMarginOrderService ========================= */
+
+// CancelMarginOrderResponse is the response of a CancelMarginOrderService.
+type CancelMarginOrderResponse struct {
+	ServiceBaseResponse
+	MarginCanceledOrder
+}
+
+// CancelMarginOrderService cancels an active margin order.
+// (symbolREST and either orderId or origClientOrderId must be sent)
+type CancelMarginOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	isIsolated        *string
+	orderID           *string
+	origClientOrderID *string
+	newClientOrderID  *string
+}
+
+// Do sends the request and returns a CancelMarginOrderResponse.
+func (s *CancelMarginOrderService) Do(ctx context.Context) (*CancelMarginOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelMarginOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	p.SetIfNotNil("newClientOrderId", s.newClientOrderID)
+	return p
+}
+
+func (s *CancelMarginOrderService) parseResponse(data []byte) (*CancelMarginOrderResponse, error) {
+	resp := &CancelMarginOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelMarginOrderService) WithSymbolREST(symbolREST string) *CancelMarginOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s CancelMarginOrderService) WithIsIsolated(isIsolated bool) *CancelMarginOrderService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s CancelMarginOrderService) WithOrderID(orderID int64) *CancelMarginOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s CancelMarginOrderService) WithOrigClientOrderID(origClientOrderID string) *CancelMarginOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+// WithNewClientOrderID returns a copy of the service with newClientOrderID
+// set to the given value.
+func (s CancelMarginOrderService) WithNewClientOrderID(newClientOrderID string) *CancelMarginOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return &s
+}
+
+/* ==================== CancelAllMarginOrdersService ===================== */
+
+// CancelAllMarginOrdersResponse is the response of a
+// CancelAllMarginOrdersService. Orders that are part of an order list are
+// returned in OrderLists.
+type CancelAllMarginOrdersResponse struct {
+	ServiceBaseResponse
+	Orders     []MarginCanceledOrder
+	OrderLists []OrderListReport
+}
+
+// CancelAllMarginOrdersService cancels all active margin orders (including
+// order lists) on a symbol.
+type CancelAllMarginOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	isIsolated *string
+}
+
+// Do sends the request and returns a CancelAllMarginOrdersResponse.
+func (s *CancelAllMarginOrdersService) Do(ctx context.Context) (*CancelAllMarginOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelAllMarginOrdersService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	return p
+}
+
+func (s *CancelAllMarginOrdersService) parseResponse(data []byte) (*CancelAllMarginOrdersResponse, error) {
+	resp := &CancelAllMarginOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	orders, orderLists, err := splitOrdersAndOrderLists[MarginCanceledOrder](data)
+	if err != nil {
+		return nil, err
+	}
+	resp.Orders, resp.OrderLists = orders, orderLists
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelAllMarginOrdersService) WithSymbolREST(symbolREST string) *CancelAllMarginOrdersService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s CancelAllMarginOrdersService) WithIsIsolated(isIsolated bool) *CancelAllMarginOrdersService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+/* ==================== QueryMarginOrderService ========================== */
+
+// QueryMarginOrderResponse is the response of a QueryMarginOrderService.
+type QueryMarginOrderResponse struct {
+	ServiceBaseResponse
+	MarginOrderDetails
+}
+
+// QueryMarginOrderService checks a margin order's status.
+// (symbolREST and either orderId or origClientOrderId must be sent)
+type QueryMarginOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	isIsolated        *string
+	orderID           *string
+	origClientOrderID *string
+}
+
+// Do sends the request and returns a QueryMarginOrderResponse.
+func (s *QueryMarginOrderService) Do(ctx context.Context) (*QueryMarginOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *QueryMarginOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	return p
+}
+
+func (s *QueryMarginOrderService) parseResponse(data []byte) (*QueryMarginOrderResponse, error) {
+	resp := &QueryMarginOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s QueryMarginOrderService) WithSymbolREST(symbolREST string) *QueryMarginOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s QueryMarginOrderService) WithIsIsolated(isIsolated bool) *QueryMarginOrderService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s QueryMarginOrderService) WithOrderID(orderID int64) *QueryMarginOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s QueryMarginOrderService) WithOrigClientOrderID(origClientOrderID string) *QueryMarginOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+/* ==================== OpenMarginOrdersService ========================== */
+
+// OpenMarginOrdersService gets all open margin orders on a symbol, or on all
+// symbols if no symbol is set (cross margin only).
+type OpenMarginOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string // mandatory for isolated margin
+	isIsolated *string
+}
+
+// Do sends the request and returns a MarginOrdersResponse.
+func (s *OpenMarginOrdersService) Do(ctx context.Context) (*MarginOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseMarginOrdersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *OpenMarginOrdersService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s OpenMarginOrdersService) WithSymbolREST(symbolREST string) *OpenMarginOrdersService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s OpenMarginOrdersService) WithIsIsolated(isIsolated bool) *OpenMarginOrdersService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+/* ==================== AllMarginOrdersService =========================== */
+
+// AllMarginOrdersService gets all margin orders (active, canceled or filled)
+// on a symbol.
+type AllMarginOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	isIsolated *string
+	orderID    *string // if set, orders >= orderId are returned
+	startTime  *string
+	endTime    *string
+	limit      *string // (default: 500, max: 500)
+}
+
+// Do sends the request and returns a MarginOrdersResponse.
+func (s *AllMarginOrdersService) Do(ctx context.Context) (*MarginOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseMarginOrdersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *AllMarginOrdersService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s AllMarginOrdersService) WithSymbolREST(symbolREST string) *AllMarginOrdersService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s AllMarginOrdersService) WithIsIsolated(isIsolated bool) *AllMarginOrdersService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s AllMarginOrdersService) WithOrderID(orderID int64) *AllMarginOrdersService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s AllMarginOrdersService) WithStartTime(startTime common.TSNano) *AllMarginOrdersService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s AllMarginOrdersService) WithEndTime(endTime common.TSNano) *AllMarginOrdersService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s AllMarginOrdersService) WithLimit(limit int) *AllMarginOrdersService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== MarginTradesService ============================== */
+
+// MarginTrade is a trade of a margin account.
+type MarginTrade struct {
+	Symbol          string        `json:"symbol"`
+	IsIsolated      bool          `json:"isIsolated"`
+	ID              int64         `json:"id"`
+	OrderID         int64         `json:"orderId"`
+	Price           string        `json:"price"`
+	Qty             string        `json:"qty"`
+	Commission      string        `json:"commission"`
+	CommissionAsset string        `json:"commissionAsset"`
+	TSSTrade        common.TSNano `json:"time"`
+	IsBuyer         bool          `json:"isBuyer"`
+	IsMaker         bool          `json:"isMaker"`
+	IsBestMatch     bool          `json:"isBestMatch"`
+}
+
+// MarginTradesResponse is the response of a MarginTradesService.
+type MarginTradesResponse struct {
+	ServiceBaseResponse
+	Trades []MarginTrade
+}
+
+// MarginTradesService gets the trades of a margin account on a symbol.
+type MarginTradesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	isIsolated *string
+	orderID    *string
+	startTime  *string
+	endTime    *string
+	fromID     *string // if set, trades >= fromId are returned
+	limit      *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns a MarginTradesResponse.
+func (s *MarginTradesService) Do(ctx context.Context) (*MarginTradesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginTradesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("fromId", s.fromID)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *MarginTradesService) parseResponse(data []byte) (*MarginTradesResponse, error) {
+	resp := &MarginTradesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Trades); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s MarginTradesService) WithSymbolREST(symbolREST string) *MarginTradesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s MarginTradesService) WithIsIsolated(isIsolated bool) *MarginTradesService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s MarginTradesService) WithOrderID(orderID int64) *MarginTradesService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s MarginTradesService) WithStartTime(startTime common.TSNano) *MarginTradesService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s MarginTradesService) WithEndTime(endTime common.TSNano) *MarginTradesService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithFromID returns a copy of the service with fromID set to the given value.
+func (s MarginTradesService) WithFromID(fromID int64) *MarginTradesService {
+	fromIDStr := strconv.FormatInt(fromID, 10)
+	s.fromID = &fromIDStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s MarginTradesService) WithLimit(limit int) *MarginTradesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== MarginOrderRateLimitService ====================== */
+
+// OrderRateLimit is the current order count usage of an order rate limit.
+type OrderRateLimit struct {
+	RateLimitType common.BIRateLimitType         `json:"rateLimitType"`
+	Interval      common.BIRateLimitIntervalType `json:"interval"`
+	IntervalNum   int                            `json:"intervalNum"`
+	Limit         int                            `json:"limit"`
+	Count         int                            `json:"count"`
+}
+
+// OrderRateLimitResponse is the response of the order rate limit services.
+// The order counts are passed on to the client's rate limit manager.
+type OrderRateLimitResponse struct {
+	ServiceBaseResponse
+	RateLimits []OrderRateLimit
+}
+
+// MarginOrderRateLimitService gets the current margin order count usage for
+// all intervals.
+type MarginOrderRateLimitService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string // mandatory for isolated margin
+	isIsolated *string
+}
+
+// Do sends the request and returns an OrderRateLimitResponse.
+func (s *MarginOrderRateLimitService) Do(ctx context.Context) (*OrderRateLimitResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderRateLimitResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	updateOrderRateLimitsUsed(s.rc, &s.SM, resp)
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *MarginOrderRateLimitService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	return p
+}
+
+// parseOrderRateLimitResponse parses data into an OrderRateLimitResponse.
+func parseOrderRateLimitResponse(sm *common.ServiceMeta, data []byte) (*OrderRateLimitResponse, error) {
+	resp := &OrderRateLimitResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.RateLimits); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// updateOrderRateLimitsUsed passes the order counts of resp on to rc, if rc
+// keeps track of rate limit usage.
+func updateOrderRateLimitsUsed(rc common.RESTClient, sm *common.ServiceMeta, resp *OrderRateLimitResponse) {
+	rlu, ok := rc.(common.RateLimitUpdater)
+	if !ok {
+		return
+	}
+
+	rateLimitUpdates := make([]common.RateLimitUpdate, 0, len(resp.RateLimits))
+	for _, rl := range resp.RateLimits {
+		rateLimitUpdates = append(rateLimitUpdates, common.RateLimitUpdate{
+			EndpointType:    sm.SD.EndpointType,
+			RateLimitType:   rl.RateLimitType,
+			IntervalSeconds: rl.Interval.Seconds(rl.IntervalNum),
+			Count:           rl.Count,
+		})
+	}
+	rlu.UpdateRateLimitsUsed(rateLimitUpdates, resp.TSSRecv)
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s MarginOrderRateLimitService) WithSymbolREST(symbolREST string) *MarginOrderRateLimitService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s MarginOrderRateLimitService) WithIsIsolated(isIsolated bool) *MarginOrderRateLimitService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
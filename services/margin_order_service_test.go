@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+// mockRateLimitRestClient returns data for every request and records the
+// rate limit updates it receives as a common.RateLimitUpdater.
+type mockRateLimitRestClient struct {
+	data    []byte
+	updates []common.RateLimitUpdate
+}
+
+func (m *mockRateLimitRestClient) Do(ctx context.Context, sm *common.ServiceMeta, p url.Values) ([]byte, error) {
+	return m.data, nil
+}
+
+func (m *mockRateLimitRestClient) UpdateRateLimitsUsed(rateLimitUpdates []common.RateLimitUpdate, tssResp common.TSNano) {
+	m.updates = append(m.updates, rateLimitUpdates...)
+}
+
+var (
+	sapiCancelAllMarginOrdersData = []byte(`[
+  {
+    "symbol": "BTCUSDT",
+    "isIsolated": true,
+    "origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+    "orderId": 11,
+    "orderListId": -1,
+    "clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+    "price": "0.089853",
+    "origQty": "0.178622",
+    "executedQty": "0.000000",
+    "cummulativeQuoteQty": "0.000000",
+    "status": "CANCELED",
+    "timeInForce": "GTC",
+    "type": "LIMIT",
+    "side": "BUY",
+    "selfTradePreventionMode": "NONE"
+  },
+  {
+    "orderListId": 1929,
+    "contingencyType": "OCO",
+    "listStatusType": "ALL_DONE",
+    "listOrderStatus": "ALL_DONE",
+    "listClientOrderId": "2inzWQdDvZLHbbAmAozX2N",
+    "transactionTime": 1585230948299,
+    "symbol": "BTCUSDT",
+    "isIsolated": true,
+    "orders": [
+      {"symbol": "BTCUSDT", "orderId": 20, "clientOrderId": "CwOOIPHSmYywx6jZX77TdL"},
+      {"symbol": "BTCUSDT", "orderId": 21, "clientOrderId": "461cPg51vQjV3zIMOXNz39"}
+    ]
+  }
+]`)
+
+	sapiAllMarginOrdersData = []byte(`[
+  {
+    "clientOrderId": "D2KDy4DIeS56PvkM13f8cP",
+    "cummulativeQuoteQty": "0.00000000",
+    "executedQty": "0.00000000",
+    "icebergQty": "0.00000000",
+    "isWorking": false,
+    "orderId": 41295,
+    "origQty": "5.31000000",
+    "price": "0.22500000",
+    "side": "SELL",
+    "status": "CANCELED",
+    "stopPrice": "0.18000000",
+    "symbol": "BNBBTC",
+    "isIsolated": false,
+    "time": 1565769338806,
+    "timeInForce": "GTC",
+    "type": "TAKE_PROFIT_LIMIT",
+    "selfTradePreventionMode": "NONE",
+    "updateTime": 1565769342148
+  }
+]`)
+
+	sapiMarginTradesData = []byte(`[
+  {
+    "commission": "0.00006000",
+    "commissionAsset": "BTC",
+    "id": 34,
+    "isBestMatch": true,
+    "isBuyer": false,
+    "isMaker": false,
+    "orderId": 39324,
+    "price": "0.02000000",
+    "qty": "3.00000000",
+    "symbol": "BNBBTC",
+    "isIsolated": false,
+    "time": 1561973357171
+  }
+]`)
+
+	sapiMarginOrderRateLimitData = []byte(`[
+  {"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 10000, "count": 0},
+  {"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 20000, "count": 0}
+]`)
+)
+
+func TestCancelAllMarginOrdersService(t *testing.T) {
+	service := NewCancelAllMarginOrdersService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithIsIsolated(true)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "isIsolated": "TRUE"}, service.toParams())
+
+	resp, err := service.parseResponse(sapiCancelAllMarginOrdersData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Orders, 1)
+	assert.Len(t, resp.OrderLists, 1)
+	assert.True(t, resp.Orders[0].IsIsolated)
+	assert.Equal(t, "E6APeyTJvkMvLMYMqu1KQ4", resp.Orders[0].OrigClientOrderID)
+	assert.Equal(t, int64(1929), resp.OrderLists[0].OrderListID)
+	assert.Equal(t, int64(21), resp.OrderLists[0].Orders[1].OrderID)
+}
+
+func TestAllMarginOrdersService(t *testing.T) {
+	service := NewAllMarginOrdersService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BNBBTC").
+		WithStartTime(common.NewTSNano(1565769338806)).
+		WithLimit(10)
+	assert.Equal(t, params{"symbol": "BNBBTC", "startTime": "1565769338806", "limit": "10"}, service.toParams())
+
+	resp, err := parseMarginOrdersResponse(&service.SM, sapiAllMarginOrdersData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Orders, 1)
+	assert.Equal(t, int64(41295), resp.Orders[0].OrderID)
+	assert.Equal(t, common.OrderTypeTakeProfitLimit, resp.Orders[0].OrderType)
+	assert.Equal(t, "0.18000000", resp.Orders[0].StopPrice)
+	assert.Equal(t, common.NewTSNano(1565769342148), resp.Orders[0].TSSUpdate)
+}
+
+func TestMarginTradesService(t *testing.T) {
+	service := NewMarginTradesService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BNBBTC").
+		WithIsIsolated(false).
+		WithFromID(34)
+	assert.Equal(t, params{"symbol": "BNBBTC", "isIsolated": "FALSE", "fromId": "34"}, service.toParams())
+
+	resp, err := service.parseResponse(sapiMarginTradesData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Trades, 1)
+	assert.Equal(t, int64(34), resp.Trades[0].ID)
+	assert.Equal(t, int64(39324), resp.Trades[0].OrderID)
+	assert.True(t, resp.Trades[0].IsBestMatch)
+	assert.Equal(t, common.NewTSNano(1561973357171), resp.Trades[0].TSSTrade)
+}
+
+func TestMarginOrderRateLimitService(t *testing.T) {
+	service := NewMarginOrderRateLimitService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithIsIsolated(true)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "isIsolated": "TRUE"}, service.toParams())
+
+	resp, err := parseOrderRateLimitResponse(&service.SM, sapiMarginOrderRateLimitData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.RateLimits, 2)
+	assert.Equal(t, common.RateLimitTypeUID, resp.RateLimits[0].RateLimitType)
+	assert.Equal(t, common.IntervalDay, resp.RateLimits[1].Interval)
+	assert.Equal(t, 20000, resp.RateLimits[1].Limit)
+}
+
+func TestMarginOrderRateLimitServiceUpdatesRateLimits(t *testing.T) {
+	rc := &mockRateLimitRestClient{data: sapiMarginOrderRateLimitData}
+	_, err := NewMarginOrderRateLimitService(rc, log.NewEntry(log.New())).Do(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []common.RateLimitUpdate{
+		{EndpointType: common.EndpointTypeSAPI, RateLimitType: common.RateLimitTypeUID, IntervalSeconds: 10, Count: 0},
+		{EndpointType: common.EndpointTypeSAPI, RateLimitType: common.RateLimitTypeUID, IntervalSeconds: 86400, Count: 0},
+	}, rc.updates)
+}
@@ -0,0 +1,531 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+const (
+	tsMilli common.TSNano = common.TSNano(time.Millisecond)
+
+	// aggTradesMaxWindow is the maximum time between startTime and endTime
+	// of an aggTrades request.
+	aggTradesMaxWindow common.TSNano = common.TSNano(time.Hour)
+)
+
+/* ==================== KlinesService ==================================== */
+
+// Kline is a kline (candlestick) of a klines or uiKlines response.
+type Kline struct {
+	TSSOpen             common.TSNano
+	Open                string
+	High                string
+	Low                 string
+	Close               string
+	Volume              string
+	TSSClose            common.TSNano
+	QuoteVolume         string
+	NumberOfTrades      int64
+	TakerBuyVolume      string
+	TakerBuyQuoteVolume string
+}
+
+// UnmarshalJSON unmarshals a kline from a JSON array to a Kline.
+func (k *Kline) UnmarshalJSON(data []byte) error {
+	var tmp []json.RawMessage
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	fields := []interface{}{
+		&k.TSSOpen, &k.Open, &k.High, &k.Low, &k.Close, &k.Volume, &k.TSSClose,
+		&k.QuoteVolume, &k.NumberOfTrades, &k.TakerBuyVolume, &k.TakerBuyQuoteVolume,
+	}
+	for i := 0; i < len(fields) && i < len(tmp); i++ {
+		if err := json.Unmarshal(tmp[i], fields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KlinesResponse is the response of a KlinesService.
+type KlinesResponse struct {
+	ServiceBaseResponse
+	Klines []Kline
+}
+
+// KlinesService gets the klines of a symbol. It is used for spot/margin
+// klines and uiKlines, and for futures klines. Klines are uniquely
+// identified by their open time.
+type KlinesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	maxLimit   int // (this needs to be initialized when the service is created)
+	symbolREST string
+	interval   common.BIKlineInterval
+	startTime  *string
+	endTime    *string
+	timeZone   *string // (SPOT & MARGIN)
+	limit      *string // (default: 500)
+}
+
+// Do sends the request and returns a KlinesResponse.
+func (s *KlinesService) Do(ctx context.Context) (*KlinesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *KlinesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("interval", string(s.interval))
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("timeZone", s.timeZone)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *KlinesService) parseResponse(data []byte) (*KlinesResponse, error) {
+	resp := &KlinesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Klines); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Pager returns a Pager that walks all klines with an open time between
+// startTime and endTime (inclusive), starting with the oldest kline. Pages
+// are of the size set with WithLimit, or of the maximum limit otherwise.
+func (s KlinesService) Pager(startTime, endTime common.TSNano) *Pager[Kline] {
+	limit := pageLimit(s.limit, s.maxLimit)
+	svc := s.WithLimit(limit).WithEndTime(endTime)
+	next := startTime
+
+	return newPager(func(ctx context.Context) ([]Kline, bool, error) {
+		resp, err := svc.WithStartTime(next).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		klines := resp.Klines
+		if len(klines) == 0 {
+			return nil, true, nil
+		}
+		next = klines[len(klines)-1].TSSOpen + tsMilli
+		return klines, len(klines) < limit || next > endTime, nil
+	})
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s KlinesService) WithSymbolREST(symbolREST string) *KlinesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithInterval returns a copy of the service with interval set to the given value.
+func (s KlinesService) WithInterval(interval common.BIKlineInterval) *KlinesService {
+	s.interval = interval
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s KlinesService) WithStartTime(startTime common.TSNano) *KlinesService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s KlinesService) WithEndTime(endTime common.TSNano) *KlinesService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithTimeZone returns a copy of the service with timeZone set to the given
+// value (e.g. "-1:00", "05:45", "0"). Only supported by spot/margin klines.
+func (s KlinesService) WithTimeZone(timeZone string) *KlinesService {
+	s.timeZone = &timeZone
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+// The request weight of futures klines depends on the limit.
+func (s KlinesService) WithLimit(limit int) *KlinesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	if s.SM.SD.EndpointType == common.EndpointTypeFAPI {
+		s.SM.SD.WeightIP = futuresKlinesWeight(limit)
+	}
+	return &s
+}
+
+// futuresKlinesWeight returns the request weight of futures klines for limit.
+func futuresKlinesWeight(limit int) int {
+	switch {
+	case limit < 100:
+		return 1
+	case limit < 500:
+		return 2
+	case limit <= 1000:
+		return 5
+	default:
+		return 10
+	}
+}
+
+/* ==================== RecentTradesService ============================== */
+
+// MarketTrade is a public trade of a trades or historicalTrades response.
+type MarketTrade struct {
+	ID           int64         `json:"id"`
+	Price        string        `json:"price"`
+	Qty          string        `json:"qty"`
+	QuoteQty     string        `json:"quoteQty"`
+	TSSTrade     common.TSNano `json:"time"`
+	IsBuyerMaker bool          `json:"isBuyerMaker"`
+	IsBestMatch  bool          `json:"isBestMatch"` // (SPOT & MARGIN)
+}
+
+// MarketTradesResponse is the response of a RecentTradesService or a
+// HistoricalTradesService.
+type MarketTradesResponse struct {
+	ServiceBaseResponse
+	Trades []MarketTrade
+}
+
+func parseMarketTradesResponse(sm *common.ServiceMeta, data []byte) (*MarketTradesResponse, error) {
+	resp := &MarketTradesResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Trades); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RecentTradesService gets the most recent public trades of a symbol.
+type RecentTradesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	limit      *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns a MarketTradesResponse.
+func (s *RecentTradesService) Do(ctx context.Context) (*MarketTradesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseMarketTradesResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *RecentTradesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s RecentTradesService) WithSymbolREST(symbolREST string) *RecentTradesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s RecentTradesService) WithLimit(limit int) *RecentTradesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== HistoricalTradesService ========================== */
+
+// HistoricalTradesService gets older public trades of a symbol, starting
+// from a trade id.
+type HistoricalTradesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	maxLimit   int // (this needs to be initialized when the service is created)
+	symbolREST string
+	fromID     *string // (default: most recent trades)
+	limit      *string // (default: 500)
+}
+
+// Do sends the request and returns a MarketTradesResponse.
+func (s *HistoricalTradesService) Do(ctx context.Context) (*MarketTradesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseMarketTradesResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *HistoricalTradesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("fromId", s.fromID)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+// Pager returns a Pager that walks all trades from fromID up to the last
+// trade before or at endTime. Historical trades can not be queried by time.
+func (s HistoricalTradesService) Pager(fromID int64, endTime common.TSNano) *Pager[MarketTrade] {
+	limit := pageLimit(s.limit, s.maxLimit)
+	svc := s.WithLimit(limit)
+	next := fromID
+
+	return newPager(func(ctx context.Context) ([]MarketTrade, bool, error) {
+		resp, err := svc.WithFromID(next).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		trades := resp.Trades
+		n := sort.Search(len(trades), func(i int) bool { return trades[i].TSSTrade > endTime })
+		if n == 0 {
+			return nil, true, nil
+		}
+		next = trades[n-1].ID + 1
+		return trades[:n], n < len(trades) || len(trades) < limit, nil
+	})
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s HistoricalTradesService) WithSymbolREST(symbolREST string) *HistoricalTradesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithFromID returns a copy of the service with fromID set to the given value.
+func (s HistoricalTradesService) WithFromID(fromID int64) *HistoricalTradesService {
+	fromIDStr := strconv.FormatInt(fromID, 10)
+	s.fromID = &fromIDStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s HistoricalTradesService) WithLimit(limit int) *HistoricalTradesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== AggTradesService ================================= */
+
+// AggTrade is a compressed, aggregate trade. Trades that fill at the same
+// time, from the same order, with the same price are aggregated.
+type AggTrade struct {
+	AggregateTradeID int64         `json:"a"`
+	Price            string        `json:"p"`
+	Qty              string        `json:"q"`
+	FirstTradeID     int64         `json:"f"`
+	LastTradeID      int64         `json:"l"`
+	TSSTrade         common.TSNano `json:"T"`
+	IsBuyerMaker     bool          `json:"m"`
+	IsBestMatch      bool          `json:"M"` // (SPOT & MARGIN)
+}
+
+// AggTradesResponse is the response of an AggTradesService.
+type AggTradesResponse struct {
+	ServiceBaseResponse
+	AggTrades []AggTrade
+}
+
+// AggTradesService gets the aggregate trades of a symbol, either from an
+// aggregate trade id or between startTime and endTime (at most 1 hour
+// apart).
+type AggTradesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	maxLimit   int // (this needs to be initialized when the service is created)
+	symbolREST string
+	fromID     *string
+	startTime  *string
+	endTime    *string
+	limit      *string // (default: 500)
+}
+
+// Do sends the request and returns an AggTradesResponse.
+func (s *AggTradesService) Do(ctx context.Context) (*AggTradesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *AggTradesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("fromId", s.fromID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *AggTradesService) parseResponse(data []byte) (*AggTradesResponse, error) {
+	resp := &AggTradesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.AggTrades); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Pager returns a Pager that walks all aggregate trades between startTime
+// and endTime (inclusive), starting with the oldest aggregate trade.
+// The first aggregate trade is searched for in windows of 1 hour, after
+// which the Pager continues by aggregate trade id.
+func (s AggTradesService) Pager(startTime, endTime common.TSNano) *Pager[AggTrade] {
+	limit := pageLimit(s.limit, s.maxLimit)
+	svc := s.WithLimit(limit)
+	svc.fromID, svc.startTime, svc.endTime = nil, nil, nil
+	next := startTime
+	var fromID *int64
+
+	return newPager(func(ctx context.Context) ([]AggTrade, bool, error) {
+		var req *AggTradesService
+		windowEnd := min(next+aggTradesMaxWindow-tsMilli, endTime)
+		if fromID == nil {
+			req = svc.WithStartTime(next).WithEndTime(windowEnd)
+		} else {
+			req = svc.WithFromID(*fromID)
+		}
+
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		aggTrades := resp.AggTrades
+		if fromID == nil && len(aggTrades) == 0 {
+			next = windowEnd + tsMilli
+			return nil, next > endTime, nil
+		}
+
+		n := sort.Search(len(aggTrades), func(i int) bool { return aggTrades[i].TSSTrade > endTime })
+		if n == 0 {
+			return nil, true, nil
+		}
+		isLastPage := fromID != nil && (n < len(aggTrades) || len(aggTrades) < limit)
+		nextID := aggTrades[n-1].AggregateTradeID + 1
+		fromID = &nextID
+		return aggTrades[:n], isLastPage, nil
+	})
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s AggTradesService) WithSymbolREST(symbolREST string) *AggTradesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithFromID returns a copy of the service with fromID set to the given value.
+func (s AggTradesService) WithFromID(fromID int64) *AggTradesService {
+	fromIDStr := strconv.FormatInt(fromID, 10)
+	s.fromID = &fromIDStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s AggTradesService) WithStartTime(startTime common.TSNano) *AggTradesService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s AggTradesService) WithEndTime(endTime common.TSNano) *AggTradesService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s AggTradesService) WithLimit(limit int) *AggTradesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== Helpers ========================================== */
+
+// pageLimit returns the limit of a service as an int, or maxLimit if the
+// limit is not set.
+func pageLimit(limit *string, maxLimit int) int {
+	if limit == nil {
+		return maxLimit
+	}
+	if l, err := strconv.Atoi(*limit); err == nil && l > 0 {
+		return l
+	}
+	return maxLimit
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+// mockPagesRestClient returns one response per call and records the params
+// of each call.
+type mockPagesRestClient struct {
+	responses [][]byte
+	errs      []error
+	params    []url.Values
+}
+
+func (m *mockPagesRestClient) Do(ctx context.Context, sm *common.ServiceMeta, p url.Values) ([]byte, error) {
+	i := len(m.params)
+	m.params = append(m.params, p)
+	if i < len(m.errs) && m.errs[i] != nil {
+		return nil, m.errs[i]
+	}
+	return m.responses[i], nil
+}
+
+var (
+	apiKlinesData = []byte(`[
+  [
+    1499040000000,
+    "0.01634790",
+    "0.80000000",
+    "0.01575800",
+    "0.01577100",
+    "148976.11427815",
+    1499644799999,
+    "2434.19055334",
+    308,
+    "1756.87402397",
+    "28.46694368",
+    "0"
+  ]
+]`)
+
+	fapiAggTradesData = []byte(`[
+  {"a": 26129, "p": "0.01633102", "q": "4.70443515", "f": 27781, "l": 27781, "T": 1498793709153, "m": true}
+]`)
+)
+
+func TestKlinesService(t *testing.T) {
+	service := NewSpotMarginKlinesService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BNBBTC").
+		WithInterval(common.KlineInterval1m).
+		WithStartTime(common.NewTSNano(1499040000000))
+	assert.Equal(t, params{"symbol": "BNBBTC", "interval": "1m", "startTime": "1499040000000"}, service.toParams())
+	assert.Equal(t, 2, service.WithLimit(1000).SM.SD.WeightIP)
+
+	resp, err := service.parseResponse(apiKlinesData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Klines, 1)
+	assert.Equal(t, common.NewTSNano(1499040000000), resp.Klines[0].TSSOpen)
+	assert.Equal(t, "0.01577100", resp.Klines[0].Close)
+	assert.Equal(t, common.NewTSNano(1499644799999), resp.Klines[0].TSSClose)
+	assert.Equal(t, int64(308), resp.Klines[0].NumberOfTrades)
+	assert.Equal(t, "28.46694368", resp.Klines[0].TakerBuyQuoteVolume)
+}
+
+func TestFuturesKlinesServiceWeight(t *testing.T) {
+	service := NewFuturesKlinesService(nil, log.NewEntry(log.New()))
+	assert.Equal(t, 5, service.SM.SD.WeightIP)
+	assert.Equal(t, 1, service.WithLimit(99).SM.SD.WeightIP)
+	assert.Equal(t, 2, service.WithLimit(100).SM.SD.WeightIP)
+	assert.Equal(t, 5, service.WithLimit(1000).SM.SD.WeightIP)
+	assert.Equal(t, 10, service.WithLimit(1500).SM.SD.WeightIP)
+}
+
+func TestKlinesPager(t *testing.T) {
+	rc := &mockPagesRestClient{
+		responses: [][]byte{
+			[]byte(`[[1700000040000, "1", "1", "1", "1", "1", 1700000099999, "1", 1, "1", "1", "0"],
+			         [1700000100000, "2", "2", "2", "2", "2", 1700000159999, "2", 2, "2", "2", "0"]]`),
+			[]byte(`[[1700000160000, "3", "3", "3", "3", "3", 1700000219999, "3", 3, "3", "3", "0"]]`),
+		},
+	}
+	pager := NewSpotMarginKlinesService(rc, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithInterval(common.KlineInterval1m).
+		WithLimit(2).
+		Pager(common.NewTSNano(1700000040000), common.NewTSNano(1700000600000))
+
+	klines := []Kline{}
+	for pager.Next(context.Background()) {
+		klines = append(klines, pager.Page()...)
+	}
+	assert.Nil(t, pager.Err())
+	assert.Len(t, klines, 3)
+	assert.Len(t, rc.params, 2)
+	assert.Equal(t, "1700000040000", rc.params[0].Get("startTime"))
+	assert.Equal(t, "1700000100001", rc.params[1].Get("startTime"))
+	assert.Equal(t, "1700000600000", rc.params[1].Get("endTime"))
+	assert.Equal(t, "2", rc.params[1].Get("limit"))
+}
+
+func TestAggTradesService(t *testing.T) {
+	service := NewFuturesAggTradesService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithFromID(26129)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "fromId": "26129"}, service.toParams())
+
+	resp, err := service.parseResponse(fapiAggTradesData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.AggTrades, 1)
+	assert.Equal(t, int64(26129), resp.AggTrades[0].AggregateTradeID)
+	assert.Equal(t, int64(27781), resp.AggTrades[0].LastTradeID)
+	assert.Equal(t, common.NewTSNano(1498793709153), resp.AggTrades[0].TSSTrade)
+	assert.True(t, resp.AggTrades[0].IsBuyerMaker)
+}
+
+func TestAggTradesPager(t *testing.T) {
+	rc := &mockPagesRestClient{
+		responses: [][]byte{
+			[]byte(`[]`),
+			nil,
+			[]byte(`[{"a": 10, "T": 1700003700000}, {"a": 11, "T": 1700003800000}]`),
+			[]byte(`[{"a": 12, "T": 1700003900000}, {"a": 13, "T": 1700009000000}]`),
+		},
+		errs: []error{
+			nil,
+			&common.RateLimitError{Producer: "shrimpy-binance", RetryTimeLocal: time.Now()},
+		},
+	}
+	pager := NewSpotMarginAggTradesService(rc, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithLimit(2).
+		Pager(common.NewTSNano(1700000001000), common.NewTSNano(1700008000000))
+
+	aggTrades := []AggTrade{}
+	for pager.Next(context.Background()) {
+		aggTrades = append(aggTrades, pager.Page()...)
+	}
+	assert.Nil(t, pager.Err())
+	assert.Len(t, aggTrades, 3)
+	assert.Equal(t, int64(12), aggTrades[2].AggregateTradeID)
+
+	// empty first window, rate limited retry of the second window, then by id
+	assert.Len(t, rc.params, 4)
+	assert.Equal(t, "1700000001000", rc.params[0].Get("startTime"))
+	assert.Equal(t, "1700003600999", rc.params[0].Get("endTime"))
+	assert.Equal(t, "1700003601000", rc.params[1].Get("startTime"))
+	assert.Equal(t, rc.params[1], rc.params[2])
+	assert.Equal(t, "1700003601000", rc.params[2].Get("startTime"))
+	assert.Equal(t, "1700007200999", rc.params[2].Get("endTime"))
+	assert.Equal(t, "12", rc.params[3].Get("fromId"))
+	assert.Equal(t, "", rc.params[3].Get("startTime"))
+}
+
+func TestPagerServerRateLimitError(t *testing.T) {
+	rc := &mockPagesRestClient{
+		errs: []error{&common.RateLimitError{Producer: "server", StatusCode: 429}},
+	}
+	pager := NewSpotMarginHistoricalTradesService(rc, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		Pager(0, common.TSNano(time.Now().UnixNano()))
+
+	assert.False(t, pager.Next(context.Background()))
+	assert.IsType(t, &common.RateLimitError{}, pager.Err())
+	assert.Len(t, rc.params, 1)
+	assert.Equal(t, "1000", rc.params[0].Get("limit"))
+}
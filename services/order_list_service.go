@@ -0,0 +1,1155 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== OrderList ======================================== */
+
+// OrderListOrder is an order of an order list.
+type OrderListOrder struct {
+	Symbol        string `json:"symbol"`
+	OrderID       int64  `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+}
+
+// OrderList is an order list (OCO, OTO or OTOCO).
+// OrderListID is the same as the OrderListID of the streams.OrderUpdateEvent
+// of each of the list's orders, and of the streams.ListStatusEvent.
+type OrderList struct {
+	OrderListID       int64                    `json:"orderListId"`
+	ContingencyType   common.BIContingencyType `json:"contingencyType"`
+	ListStatusType    common.BIListStatusType  `json:"listStatusType"`
+	ListOrderStatus   common.BIListOrderStatus `json:"listOrderStatus"`
+	ListClientOrderID string                   `json:"listClientOrderId"`
+	TSSTransact       common.TSNano            `json:"transactionTime"`
+	Symbol            string                   `json:"symbol"`
+	IsIsolated        bool                     `json:"isIsolated"` // (MARGIN)
+	Orders            []OrderListOrder         `json:"orders"`
+}
+
+// SpotOrderReport is the report of an order of an order list.
+// OrigClientOrderID is only set when the order list was canceled.
+type SpotOrderReport struct {
+	SpotOrder
+	OrigClientOrderID string        `json:"origClientOrderId"`
+	TSSTransact       common.TSNano `json:"transactTime"`
+}
+
+// OrderListReport is an order list with reports for each of its orders, as
+// returned when an order list is placed or canceled.
+type OrderListReport struct {
+	OrderList
+	OrderReports          []SpotOrderReport `json:"orderReports"`
+	MarginBuyBorrowAmount string            `json:"marginBuyBorrowAmount"` // (MARGIN) will not return if no margin trade happens
+	MarginBuyBorrowAsset  string            `json:"marginBuyBorrowAsset"`  // (MARGIN) will not return if no margin trade happens
+}
+
+// OrderListResponse is the response of all services that place or cancel
+// an order list.
+type OrderListResponse struct {
+	ServiceBaseResponse
+	OrderListReport
+}
+
+// parseOrderListResponse parses data into an OrderListResponse.
+func parseOrderListResponse(sm *common.ServiceMeta, data []byte) (*OrderListResponse, error) {
+	resp := &OrderListResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== OrderListLeg ===================================== */
+
+// OrderListLeg holds the parameters of one order of a spot order list.
+// The parameters are prefixed with the leg's position in the list when the
+// request is sent (e.g. price is sent as abovePrice, workingPrice or
+// pendingBelowPrice). Which parameters are valid depends on the leg; side
+// and quantity are only valid for the working and pending legs of OTO orders.
+type OrderListLeg struct {
+	orderType     string
+	side          *string
+	clientOrderID *string
+	price         *string
+	stopPrice     *string
+	trailingDelta *string
+	quantity      *string
+	icebergQty    *string
+	timeInForce   *string
+}
+
+// NewOrderListLeg creates a new OrderListLeg of orderType.
+func NewOrderListLeg(orderType common.BIOrderType) *OrderListLeg {
+	return &OrderListLeg{orderType: string(orderType)}
+}
+
+// toParams sets all parameter fields of the leg on p, prefixed with prefix.
+func (l *OrderListLeg) toParams(p params, prefix string) {
+	p.Set(prefix+"Type", l.orderType)
+	p.SetIfNotNil(prefix+"Side", l.side)
+	p.SetIfNotNil(prefix+"ClientOrderId", l.clientOrderID)
+	p.SetIfNotNil(prefix+"Price", l.price)
+	p.SetIfNotNil(prefix+"StopPrice", l.stopPrice)
+	p.SetIfNotNil(prefix+"TrailingDelta", l.trailingDelta)
+	p.SetIfNotNil(prefix+"Quantity", l.quantity)
+	p.SetIfNotNil(prefix+"IcebergQty", l.icebergQty)
+	p.SetIfNotNil(prefix+"TimeInForce", l.timeInForce)
+}
+
+// WithSide returns a copy of the leg with side set to the given value.
+func (l OrderListLeg) WithSide(side common.BIOrderSide) *OrderListLeg {
+	sideStr := string(side)
+	l.side = &sideStr
+	return &l
+}
+
+// WithClientOrderID returns a copy of the leg with clientOrderID set to the given value.
+func (l OrderListLeg) WithClientOrderID(clientOrderID string) *OrderListLeg {
+	l.clientOrderID = &clientOrderID
+	return &l
+}
+
+// WithPrice returns a copy of the leg with price set to the given value.
+func (l OrderListLeg) WithPrice(price string) *OrderListLeg {
+	l.price = &price
+	return &l
+}
+
+// WithStopPrice returns a copy of the leg with stopPrice set to the given value.
+func (l OrderListLeg) WithStopPrice(stopPrice string) *OrderListLeg {
+	l.stopPrice = &stopPrice
+	return &l
+}
+
+// WithTrailingDelta returns a copy of the leg with trailingDelta (in BIPS)
+// set to the given value.
+func (l OrderListLeg) WithTrailingDelta(trailingDelta int64) *OrderListLeg {
+	trailingDeltaStr := strconv.FormatInt(trailingDelta, 10)
+	l.trailingDelta = &trailingDeltaStr
+	return &l
+}
+
+// WithQuantity returns a copy of the leg with quantity set to the given value.
+func (l OrderListLeg) WithQuantity(quantity string) *OrderListLeg {
+	l.quantity = &quantity
+	return &l
+}
+
+// WithIcebergQty returns a copy of the leg with icebergQty set to the given value.
+func (l OrderListLeg) WithIcebergQty(icebergQty string) *OrderListLeg {
+	l.icebergQty = &icebergQty
+	return &l
+}
+
+// WithTimeInForce returns a copy of the leg with timeInForce set to the given value.
+func (l OrderListLeg) WithTimeInForce(timeInForce common.BIOrderTimeInForce) *OrderListLeg {
+	timeInForceStr := string(timeInForce)
+	l.timeInForce = &timeInForceStr
+	return &l
+}
+
+/* ==================== CreateSpotOCOOrderListService ==================== */
+
+// CreateSpotOCOOrderListService places a spot OCO (one-cancels-the-other)
+// order list. The above and below orders are set with WithAbove and
+// WithBelow.
+type CreateSpotOCOOrderListService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string
+	side                    string
+	quantity                string
+	above                   *OrderListLeg // (STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT, TAKE_PROFIT_LIMIT)
+	below                   *OrderListLeg // (STOP_LOSS, STOP_LOSS_LIMIT, TAKE_PROFIT, TAKE_PROFIT_LIMIT)
+	listClientOrderID       *string
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CreateSpotOCOOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateSpotOCOOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("side", s.side)
+	p.Set("quantity", s.quantity)
+	if s.above != nil {
+		s.above.toParams(p, "above")
+	}
+	if s.below != nil {
+		s.below.toParams(p, "below")
+	}
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateSpotOCOOrderListService) WithSymbolREST(symbolREST string) *CreateSpotOCOOrderListService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithSide returns a copy of the service with side set to the given value.
+func (s CreateSpotOCOOrderListService) WithSide(side common.BIOrderSide) *CreateSpotOCOOrderListService {
+	s.side = string(side)
+	return &s
+}
+
+// WithQuantity returns a copy of the service with quantity (of both legs) set
+// to the given value.
+func (s CreateSpotOCOOrderListService) WithQuantity(quantity string) *CreateSpotOCOOrderListService {
+	s.quantity = quantity
+	return &s
+}
+
+// WithAbove returns a copy of the service with the above leg set to the given value.
+func (s CreateSpotOCOOrderListService) WithAbove(above *OrderListLeg) *CreateSpotOCOOrderListService {
+	s.above = above
+	return &s
+}
+
+// WithBelow returns a copy of the service with the below leg set to the given value.
+func (s CreateSpotOCOOrderListService) WithBelow(below *OrderListLeg) *CreateSpotOCOOrderListService {
+	s.below = below
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CreateSpotOCOOrderListService) WithListClientOrderID(listClientOrderID string) *CreateSpotOCOOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateSpotOCOOrderListService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateSpotOCOOrderListService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateSpotOCOOrderListService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateSpotOCOOrderListService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+/* ==================== CreateSpotOTOOrderListService ==================== */
+
+// CreateSpotOTOOrderListService places a spot OTO (one-triggers-the-other)
+// order list. The pending order is only placed once the working order is
+// fully filled. The working and pending orders are set with WithWorking and
+// WithPending, and must both have side and quantity set.
+type CreateSpotOTOOrderListService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string
+	working                 *OrderListLeg // (LIMIT, LIMIT_MAKER)
+	pending                 *OrderListLeg // (all order types except MARKET orders using quoteOrderQty)
+	listClientOrderID       *string
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CreateSpotOTOOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateSpotOTOOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	if s.working != nil {
+		s.working.toParams(p, "working")
+	}
+	if s.pending != nil {
+		s.pending.toParams(p, "pending")
+	}
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateSpotOTOOrderListService) WithSymbolREST(symbolREST string) *CreateSpotOTOOrderListService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithWorking returns a copy of the service with the working leg set to the given value.
+func (s CreateSpotOTOOrderListService) WithWorking(working *OrderListLeg) *CreateSpotOTOOrderListService {
+	s.working = working
+	return &s
+}
+
+// WithPending returns a copy of the service with the pending leg set to the given value.
+func (s CreateSpotOTOOrderListService) WithPending(pending *OrderListLeg) *CreateSpotOTOOrderListService {
+	s.pending = pending
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CreateSpotOTOOrderListService) WithListClientOrderID(listClientOrderID string) *CreateSpotOTOOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateSpotOTOOrderListService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateSpotOTOOrderListService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateSpotOTOOrderListService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateSpotOTOOrderListService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+/* ==================== CreateSpotOTOCOOrderListService ================== */
+
+// CreateSpotOTOCOOrderListService places a spot OTOCO
+// (one-triggers-one-cancels-the-other) order list. Once the working order
+// is fully filled, the pending above and pending below orders are placed as
+// an OCO. The working order is set with WithWorking (side and quantity must
+// be set), the pending orders with WithPendingAbove and WithPendingBelow.
+type CreateSpotOTOCOOrderListService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string
+	pendingSide             string
+	pendingQuantity         string
+	working                 *OrderListLeg // (LIMIT, LIMIT_MAKER)
+	pendingAbove            *OrderListLeg // (STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT, TAKE_PROFIT_LIMIT)
+	pendingBelow            *OrderListLeg // (STOP_LOSS, STOP_LOSS_LIMIT, TAKE_PROFIT, TAKE_PROFIT_LIMIT)
+	listClientOrderID       *string
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CreateSpotOTOCOOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateSpotOTOCOOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("pendingSide", s.pendingSide)
+	p.Set("pendingQuantity", s.pendingQuantity)
+	if s.working != nil {
+		s.working.toParams(p, "working")
+	}
+	if s.pendingAbove != nil {
+		s.pendingAbove.toParams(p, "pendingAbove")
+	}
+	if s.pendingBelow != nil {
+		s.pendingBelow.toParams(p, "pendingBelow")
+	}
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithSymbolREST(symbolREST string) *CreateSpotOTOCOOrderListService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithPendingSide returns a copy of the service with pendingSide (of both
+// pending legs) set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithPendingSide(pendingSide common.BIOrderSide) *CreateSpotOTOCOOrderListService {
+	s.pendingSide = string(pendingSide)
+	return &s
+}
+
+// WithPendingQuantity returns a copy of the service with pendingQuantity (of
+// both pending legs) set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithPendingQuantity(pendingQuantity string) *CreateSpotOTOCOOrderListService {
+	s.pendingQuantity = pendingQuantity
+	return &s
+}
+
+// WithWorking returns a copy of the service with the working leg set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithWorking(working *OrderListLeg) *CreateSpotOTOCOOrderListService {
+	s.working = working
+	return &s
+}
+
+// WithPendingAbove returns a copy of the service with the pending above leg
+// set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithPendingAbove(pendingAbove *OrderListLeg) *CreateSpotOTOCOOrderListService {
+	s.pendingAbove = pendingAbove
+	return &s
+}
+
+// WithPendingBelow returns a copy of the service with the pending below leg
+// set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithPendingBelow(pendingBelow *OrderListLeg) *CreateSpotOTOCOOrderListService {
+	s.pendingBelow = pendingBelow
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithListClientOrderID(listClientOrderID string) *CreateSpotOTOCOOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateSpotOTOCOOrderListService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateSpotOTOCOOrderListService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateSpotOTOCOOrderListService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+/* ==================== CreateMarginOCOOrderListService ================== */
+
+// CreateMarginOCOOrderListService places a margin OCO (one-cancels-the-other)
+// order list, consisting of a LIMIT_MAKER order and a STOP_LOSS(_LIMIT)
+// order.
+type CreateMarginOCOOrderListService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string
+	side                    string
+	quantity                string
+	price                   string  // (LIMIT_MAKER)
+	stopPrice               string  // (STOP_LOSS, STOP_LOSS_LIMIT)
+	stopLimitPrice          *string // (STOP_LOSS_LIMIT) if set, stopLimitTimeInForce is required
+	stopLimitTimeInForce    *string // (GTC, FOK, IOC)
+	limitClientOrderID      *string
+	stopClientOrderID       *string
+	limitIcebergQty         *string
+	stopIcebergQty          *string
+	listClientOrderID       *string
+	isIsolated              *string // (TRUE, FALSE) (default: FALSE)
+	sideEffectType          *string // (NO_SIDE_EFFECT, MARGIN_BUY, AUTO_REPAY) (default: NO_SIDE_EFFECT)
+	autoRepayAtCancel       *string // (true, false) (default: true)
+	selfTradePreventionMode *string
+	newOrderRespType        *string
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CreateMarginOCOOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateMarginOCOOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("side", s.side)
+	p.Set("quantity", s.quantity)
+	p.Set("price", s.price)
+	p.Set("stopPrice", s.stopPrice)
+
+	p.SetIfNotNil("stopLimitPrice", s.stopLimitPrice)
+	p.SetIfNotNil("stopLimitTimeInForce", s.stopLimitTimeInForce)
+	p.SetIfNotNil("limitClientOrderId", s.limitClientOrderID)
+	p.SetIfNotNil("stopClientOrderId", s.stopClientOrderID)
+	p.SetIfNotNil("limitIcebergQty", s.limitIcebergQty)
+	p.SetIfNotNil("stopIcebergQty", s.stopIcebergQty)
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("sideEffectType", s.sideEffectType)
+	p.SetIfNotNil("autoRepayAtCancel", s.autoRepayAtCancel)
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	return p
+}
+
+// WithOCOParams returns a copy of the service with all parameters that are
+// mandatory for a margin OCO order list set.
+// Any optional params must be set before or after calling this method.
+func (s CreateMarginOCOOrderListService) WithOCOParams(
+	symbolREST string, side common.BIOrderSide, quantity, price, stopPrice string,
+) *CreateMarginOCOOrderListService {
+	s.symbolREST = symbolREST
+	s.side = string(side)
+	s.quantity = quantity
+	s.price = price
+	s.stopPrice = stopPrice
+	return &s
+}
+
+// WithStopLimitPrice returns a copy of the service with stopLimitPrice and
+// stopLimitTimeInForce set to the given values.
+func (s CreateMarginOCOOrderListService) WithStopLimitPrice(
+	stopLimitPrice string, stopLimitTimeInForce common.BIOrderTimeInForce,
+) *CreateMarginOCOOrderListService {
+	stopLimitTimeInForceStr := string(stopLimitTimeInForce)
+	s.stopLimitPrice = &stopLimitPrice
+	s.stopLimitTimeInForce = &stopLimitTimeInForceStr
+	return &s
+}
+
+// WithLimitClientOrderID returns a copy of the service with
+// limitClientOrderID set to the given value.
+func (s CreateMarginOCOOrderListService) WithLimitClientOrderID(limitClientOrderID string) *CreateMarginOCOOrderListService {
+	s.limitClientOrderID = &limitClientOrderID
+	return &s
+}
+
+// WithStopClientOrderID returns a copy of the service with stopClientOrderID
+// set to the given value.
+func (s CreateMarginOCOOrderListService) WithStopClientOrderID(stopClientOrderID string) *CreateMarginOCOOrderListService {
+	s.stopClientOrderID = &stopClientOrderID
+	return &s
+}
+
+// WithLimitIcebergQty returns a copy of the service with limitIcebergQty set
+// to the given value.
+func (s CreateMarginOCOOrderListService) WithLimitIcebergQty(limitIcebergQty string) *CreateMarginOCOOrderListService {
+	s.limitIcebergQty = &limitIcebergQty
+	return &s
+}
+
+// WithStopIcebergQty returns a copy of the service with stopIcebergQty set
+// to the given value.
+func (s CreateMarginOCOOrderListService) WithStopIcebergQty(stopIcebergQty string) *CreateMarginOCOOrderListService {
+	s.stopIcebergQty = &stopIcebergQty
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CreateMarginOCOOrderListService) WithListClientOrderID(listClientOrderID string) *CreateMarginOCOOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s CreateMarginOCOOrderListService) WithIsIsolated(isIsolated bool) *CreateMarginOCOOrderListService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithSideEffectType returns a copy of the service with sideEffectType set to the given value.
+func (s CreateMarginOCOOrderListService) WithSideEffectType(sideEffectType common.BIOrderSideEffect) *CreateMarginOCOOrderListService {
+	sideEffectTypeStr := string(sideEffectType)
+	s.sideEffectType = &sideEffectTypeStr
+	return &s
+}
+
+// WithAutoRepayAtCancel returns a copy of the service with autoRepayAtCancel set to the given value.
+func (s CreateMarginOCOOrderListService) WithAutoRepayAtCancel(autoRepayAtCancel bool) *CreateMarginOCOOrderListService {
+	autoRepayAtCancelStr := strconv.FormatBool(autoRepayAtCancel)
+	s.autoRepayAtCancel = &autoRepayAtCancelStr
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateMarginOCOOrderListService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateMarginOCOOrderListService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateMarginOCOOrderListService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateMarginOCOOrderListService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+/* ==================== CreateMarginOTOOrderListService ================== */
+
+// CreateMarginOTOOrderListService places a margin OTO (one-triggers-the-other)
+// order list. The pending order is only placed once the working order is
+// fully filled. The working and pending orders are set with WithWorking and
+// WithPending, and must both have side and quantity set.
+type CreateMarginOTOOrderListService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string
+	working                 *OrderListLeg // (LIMIT, LIMIT_MAKER)
+	pending                 *OrderListLeg // (all order types except MARKET orders using quoteOrderQty)
+	listClientOrderID       *string
+	isIsolated              *string // (TRUE, FALSE) (default: FALSE)
+	sideEffectType          *string // (NO_SIDE_EFFECT, MARGIN_BUY) (default: NO_SIDE_EFFECT)
+	autoRepayAtCancel       *string // (true, false) (default: true)
+	selfTradePreventionMode *string
+	newOrderRespType        *string
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CreateMarginOTOOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateMarginOTOOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	if s.working != nil {
+		s.working.toParams(p, "working")
+	}
+	if s.pending != nil {
+		s.pending.toParams(p, "pending")
+	}
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("sideEffectType", s.sideEffectType)
+	p.SetIfNotNil("autoRepayAtCancel", s.autoRepayAtCancel)
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateMarginOTOOrderListService) WithSymbolREST(symbolREST string) *CreateMarginOTOOrderListService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithWorking returns a copy of the service with the working leg set to the given value.
+func (s CreateMarginOTOOrderListService) WithWorking(working *OrderListLeg) *CreateMarginOTOOrderListService {
+	s.working = working
+	return &s
+}
+
+// WithPending returns a copy of the service with the pending leg set to the given value.
+func (s CreateMarginOTOOrderListService) WithPending(pending *OrderListLeg) *CreateMarginOTOOrderListService {
+	s.pending = pending
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CreateMarginOTOOrderListService) WithListClientOrderID(listClientOrderID string) *CreateMarginOTOOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s CreateMarginOTOOrderListService) WithIsIsolated(isIsolated bool) *CreateMarginOTOOrderListService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithSideEffectType returns a copy of the service with sideEffectType set
+// to the given value (NO_SIDE_EFFECT or MARGIN_BUY).
+func (s CreateMarginOTOOrderListService) WithSideEffectType(sideEffectType common.BIOrderSideEffect) *CreateMarginOTOOrderListService {
+	sideEffectTypeStr := string(sideEffectType)
+	s.sideEffectType = &sideEffectTypeStr
+	return &s
+}
+
+// WithAutoRepayAtCancel returns a copy of the service with autoRepayAtCancel set to the given value.
+func (s CreateMarginOTOOrderListService) WithAutoRepayAtCancel(autoRepayAtCancel bool) *CreateMarginOTOOrderListService {
+	autoRepayAtCancelStr := strconv.FormatBool(autoRepayAtCancel)
+	s.autoRepayAtCancel = &autoRepayAtCancelStr
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateMarginOTOOrderListService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateMarginOTOOrderListService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateMarginOTOOrderListService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateMarginOTOOrderListService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+/* ==================== CreateMarginOTOCOOrderListService ================ */
+
+// CreateMarginOTOCOOrderListService places a margin OTOCO
+// (one-triggers-one-cancels-the-other) order list. Once the working order
+// is fully filled, the pending above and pending below orders are placed as
+// an OCO. The working order is set with WithWorking (side and quantity must
+// be set), the pending orders with WithPendingAbove and WithPendingBelow.
+type CreateMarginOTOCOOrderListService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string
+	pendingSide             string
+	pendingQuantity         string
+	working                 *OrderListLeg // (LIMIT, LIMIT_MAKER)
+	pendingAbove            *OrderListLeg // (LIMIT_MAKER, STOP_LOSS, STOP_LOSS_LIMIT)
+	pendingBelow            *OrderListLeg // (LIMIT_MAKER, STOP_LOSS, STOP_LOSS_LIMIT)
+	listClientOrderID       *string
+	isIsolated              *string // (TRUE, FALSE) (default: FALSE)
+	sideEffectType          *string // (NO_SIDE_EFFECT, MARGIN_BUY) (default: NO_SIDE_EFFECT)
+	autoRepayAtCancel       *string // (true, false) (default: true)
+	selfTradePreventionMode *string
+	newOrderRespType        *string
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CreateMarginOTOCOOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateMarginOTOCOOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("pendingSide", s.pendingSide)
+	p.Set("pendingQuantity", s.pendingQuantity)
+	if s.working != nil {
+		s.working.toParams(p, "working")
+	}
+	if s.pendingAbove != nil {
+		s.pendingAbove.toParams(p, "pendingAbove")
+	}
+	if s.pendingBelow != nil {
+		s.pendingBelow.toParams(p, "pendingBelow")
+	}
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	p.SetIfNotNil("sideEffectType", s.sideEffectType)
+	p.SetIfNotNil("autoRepayAtCancel", s.autoRepayAtCancel)
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithSymbolREST(symbolREST string) *CreateMarginOTOCOOrderListService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithPendingSide returns a copy of the service with pendingSide (of both
+// pending legs) set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithPendingSide(pendingSide common.BIOrderSide) *CreateMarginOTOCOOrderListService {
+	s.pendingSide = string(pendingSide)
+	return &s
+}
+
+// WithPendingQuantity returns a copy of the service with pendingQuantity (of
+// both pending legs) set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithPendingQuantity(pendingQuantity string) *CreateMarginOTOCOOrderListService {
+	s.pendingQuantity = pendingQuantity
+	return &s
+}
+
+// WithWorking returns a copy of the service with the working leg set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithWorking(working *OrderListLeg) *CreateMarginOTOCOOrderListService {
+	s.working = working
+	return &s
+}
+
+// WithPendingAbove returns a copy of the service with the pending above leg
+// set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithPendingAbove(pendingAbove *OrderListLeg) *CreateMarginOTOCOOrderListService {
+	s.pendingAbove = pendingAbove
+	return &s
+}
+
+// WithPendingBelow returns a copy of the service with the pending below leg
+// set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithPendingBelow(pendingBelow *OrderListLeg) *CreateMarginOTOCOOrderListService {
+	s.pendingBelow = pendingBelow
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithListClientOrderID(listClientOrderID string) *CreateMarginOTOCOOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithIsIsolated(isIsolated bool) *CreateMarginOTOCOOrderListService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+// WithSideEffectType returns a copy of the service with sideEffectType set
+// to the given value (NO_SIDE_EFFECT or MARGIN_BUY).
+func (s CreateMarginOTOCOOrderListService) WithSideEffectType(sideEffectType common.BIOrderSideEffect) *CreateMarginOTOCOOrderListService {
+	sideEffectTypeStr := string(sideEffectType)
+	s.sideEffectType = &sideEffectTypeStr
+	return &s
+}
+
+// WithAutoRepayAtCancel returns a copy of the service with autoRepayAtCancel set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithAutoRepayAtCancel(autoRepayAtCancel bool) *CreateMarginOTOCOOrderListService {
+	autoRepayAtCancelStr := strconv.FormatBool(autoRepayAtCancel)
+	s.autoRepayAtCancel = &autoRepayAtCancelStr
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateMarginOTOCOOrderListService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateMarginOTOCOOrderListService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateMarginOTOCOOrderListService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+/* ==================== CancelOrderListService =========================== */
+
+// CancelOrderListService cancels an entire order list (SPOT & MARGIN).
+// (symbolREST and either orderListId or listClientOrderId must be sent)
+type CancelOrderListService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	orderListID       *string
+	listClientOrderID *string
+	newClientOrderID  *string
+	isIsolated        *string // (MARGIN)
+}
+
+// Do sends the request and returns an OrderListResponse.
+func (s *CancelOrderListService) Do(ctx context.Context) (*OrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderListResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelOrderListService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderListId", s.orderListID)
+	p.SetIfNotNil("listClientOrderId", s.listClientOrderID)
+	p.SetIfNotNil("newClientOrderId", s.newClientOrderID)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelOrderListService) WithSymbolREST(symbolREST string) *CancelOrderListService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderListID returns a copy of the service with orderListID set to the given value.
+func (s CancelOrderListService) WithOrderListID(orderListID int64) *CancelOrderListService {
+	orderListIDStr := strconv.FormatInt(orderListID, 10)
+	s.orderListID = &orderListIDStr
+	return &s
+}
+
+// WithListClientOrderID returns a copy of the service with listClientOrderID
+// set to the given value.
+func (s CancelOrderListService) WithListClientOrderID(listClientOrderID string) *CancelOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return &s
+}
+
+// WithNewClientOrderID returns a copy of the service with newClientOrderID
+// set to the given value.
+func (s CancelOrderListService) WithNewClientOrderID(newClientOrderID string) *CancelOrderListService {
+	s.newClientOrderID = &newClientOrderID
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the
+// given value (MARGIN only).
+func (s CancelOrderListService) WithIsIsolated(isIsolated bool) *CancelOrderListService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+/* ==================== QueryOrderListService ============================ */
+
+// QueryOrderListResponse is the response of a QueryOrderListService.
+type QueryOrderListResponse struct {
+	ServiceBaseResponse
+	OrderList
+}
+
+// QueryOrderListService gets an order list (SPOT & MARGIN).
+// (either orderListId or origClientOrderId must be sent)
+type QueryOrderListService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	orderListID       *string
+	origClientOrderID *string
+	symbolREST        *string // (MARGIN) mandatory for isolated margin
+	isIsolated        *string // (MARGIN)
+}
+
+// Do sends the request and returns a QueryOrderListResponse.
+func (s *QueryOrderListService) Do(ctx context.Context) (*QueryOrderListResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *QueryOrderListService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("orderListId", s.orderListID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	return p
+}
+
+func (s *QueryOrderListService) parseResponse(data []byte) (*QueryOrderListResponse, error) {
+	resp := &QueryOrderListResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithOrderListID returns a copy of the service with orderListID set to the given value.
+func (s QueryOrderListService) WithOrderListID(orderListID int64) *QueryOrderListService {
+	orderListIDStr := strconv.FormatInt(orderListID, 10)
+	s.orderListID = &orderListIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// (the listClientOrderId) set to the given value.
+func (s QueryOrderListService) WithOrigClientOrderID(origClientOrderID string) *QueryOrderListService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value (MARGIN only).
+func (s QueryOrderListService) WithSymbolREST(symbolREST string) *QueryOrderListService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the
+// given value (MARGIN only).
+func (s QueryOrderListService) WithIsIsolated(isIsolated bool) *QueryOrderListService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
+
+/* ==================== OpenOrderListsService ============================ */
+
+// OpenOrderListsResponse is the response of an OpenOrderListsService.
+type OpenOrderListsResponse struct {
+	ServiceBaseResponse
+	OrderLists []OrderList
+}
+
+// OpenOrderListsService gets all open order lists (SPOT & MARGIN).
+type OpenOrderListsService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string // (MARGIN) mandatory for isolated margin
+	isIsolated *string // (MARGIN)
+}
+
+// Do sends the request and returns an OpenOrderListsResponse.
+func (s *OpenOrderListsService) Do(ctx context.Context) (*OpenOrderListsResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *OpenOrderListsService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetIfNotNil("isIsolated", s.isIsolated)
+	return p
+}
+
+func (s *OpenOrderListsService) parseResponse(data []byte) (*OpenOrderListsResponse, error) {
+	resp := &OpenOrderListsResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.OrderLists); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value (MARGIN only).
+func (s OpenOrderListsService) WithSymbolREST(symbolREST string) *OpenOrderListsService {
+	s.symbolREST = &symbolREST
+	return &s
+}
+
+// WithIsIsolated returns a copy of the service with isIsolated set to the
+// given value (MARGIN only).
+func (s OpenOrderListsService) WithIsIsolated(isIsolated bool) *OpenOrderListsService {
+	isIsolatedStr := boolToUpperStr(isIsolated)
+	s.isIsolated = &isIsolatedStr
+	return &s
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiCreateOCOOrderListData = []byte(`{
+  "orderListId": 1,
+  "contingencyType": "OCO",
+  "listStatusType": "EXEC_STARTED",
+  "listOrderStatus": "EXECUTING",
+  "listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+  "transactionTime": 1710485608839,
+  "symbol": "LTCBTC",
+  "orders": [
+    {"symbol": "LTCBTC", "orderId": 10, "clientOrderId": "44nZvqpemY7sVYgPYbvPih"},
+    {"symbol": "LTCBTC", "orderId": 11, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"}
+  ],
+  "orderReports": [
+    {
+      "symbol": "LTCBTC",
+      "orderId": 10,
+      "orderListId": 1,
+      "clientOrderId": "44nZvqpemY7sVYgPYbvPih",
+      "transactTime": 1710485608839,
+      "price": "1.00000000",
+      "origQty": "5.00000000",
+      "executedQty": "0.00000000",
+      "origQuoteOrderQty": "0.000000",
+      "cummulativeQuoteQty": "0.00000000",
+      "status": "NEW",
+      "timeInForce": "GTC",
+      "type": "STOP_LOSS_LIMIT",
+      "side": "SELL",
+      "stopPrice": "1.00000000",
+      "workingTime": -1,
+      "icebergQty": "1.00000000",
+      "selfTradePreventionMode": "NONE"
+    },
+    {
+      "symbol": "LTCBTC",
+      "orderId": 11,
+      "orderListId": 1,
+      "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK",
+      "transactTime": 1710485608839,
+      "price": "3.00000000",
+      "origQty": "5.00000000",
+      "executedQty": "0.00000000",
+      "origQuoteOrderQty": "0.000000",
+      "cummulativeQuoteQty": "0.00000000",
+      "status": "NEW",
+      "timeInForce": "GTC",
+      "type": "LIMIT_MAKER",
+      "side": "SELL",
+      "workingTime": 1710485608839,
+      "selfTradePreventionMode": "NONE"
+    }
+  ]
+}`)
+
+	apiOpenOrderListsData = []byte(`[
+  {
+    "orderListId": 31,
+    "contingencyType": "OCO",
+    "listStatusType": "EXEC_STARTED",
+    "listOrderStatus": "EXECUTING",
+    "listClientOrderId": "wuB13fmulKj3YjdqWEcsnp",
+    "transactionTime": 1565246080644,
+    "symbol": "LTCBTC",
+    "isIsolated": true,
+    "orders": [
+      {"symbol": "LTCBTC", "orderId": 4, "clientOrderId": "r3EH2N76dHfLoSZWIUw1bT"},
+      {"symbol": "LTCBTC", "orderId": 5, "clientOrderId": "Cv1SnyPD3qhqpbjpYEHbd2"}
+    ]
+  }
+]`)
+)
+
+func TestCreateSpotOCOOrderListService(t *testing.T) {
+	service := NewCreateSpotOCOOrderListService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("LTCBTC").
+		WithSide(common.OrderSideSell).
+		WithQuantity("5").
+		WithAbove(NewOrderListLeg(common.OrderTypeLimitMaker).WithPrice("3")).
+		WithBelow(NewOrderListLeg(common.OrderTypeStopLossLimit).WithPrice("1").WithStopPrice("1").WithTimeInForce(common.OrderTimeInForceGTC))
+
+	assert.Equal(t, params{
+		"symbol": "LTCBTC", "side": "SELL", "quantity": "5",
+		"aboveType": "LIMIT_MAKER", "abovePrice": "3",
+		"belowType": "STOP_LOSS_LIMIT", "belowPrice": "1", "belowStopPrice": "1", "belowTimeInForce": "GTC",
+	}, service.toParams())
+
+	resp, err := parseOrderListResponse(&service.SM, apiCreateOCOOrderListData)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), resp.OrderListID)
+	assert.Equal(t, common.ContingencyTypeOCO, resp.ContingencyType)
+	assert.Equal(t, common.ListStatusTypeExecStarted, resp.ListStatusType)
+	assert.Equal(t, common.ListOrderStatusExecuting, resp.ListOrderStatus)
+	assert.Len(t, resp.Orders, 2)
+	assert.Len(t, resp.OrderReports, 2)
+
+	// every leg links back to the order list
+	for _, report := range resp.OrderReports {
+		assert.Equal(t, resp.OrderListID, report.OrderListID)
+	}
+	assert.Equal(t, common.OrderTypeLimitMaker, resp.OrderReports[1].OrderType)
+}
+
+func TestCreateSpotOTOCOOrderListService(t *testing.T) {
+	service := NewCreateSpotOTOCOOrderListService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("LTCBTC").
+		WithWorking(NewOrderListLeg(common.OrderTypeLimit).WithSide(common.OrderSideBuy).WithQuantity("1").WithPrice("2").WithTimeInForce(common.OrderTimeInForceGTC)).
+		WithPendingSide(common.OrderSideSell).
+		WithPendingQuantity("1").
+		WithPendingAbove(NewOrderListLeg(common.OrderTypeLimitMaker).WithPrice("3")).
+		WithPendingBelow(NewOrderListLeg(common.OrderTypeStopLoss).WithTrailingDelta(100))
+
+	assert.Equal(t, params{
+		"symbol": "LTCBTC", "pendingSide": "SELL", "pendingQuantity": "1",
+		"workingType": "LIMIT", "workingSide": "BUY", "workingQuantity": "1", "workingPrice": "2", "workingTimeInForce": "GTC",
+		"pendingAboveType": "LIMIT_MAKER", "pendingAbovePrice": "3",
+		"pendingBelowType": "STOP_LOSS", "pendingBelowTrailingDelta": "100",
+	}, service.toParams())
+}
+
+func TestCreateMarginOCOOrderListService(t *testing.T) {
+	service := NewCreateMarginOCOOrderListService(nil, log.NewEntry(log.New())).
+		WithOCOParams("BNBUSDT", common.OrderSideSell, "0.1", "400", "300").
+		WithStopLimitPrice("290", common.OrderTimeInForceGTC).
+		WithIsIsolated(true).
+		WithSideEffectType(common.SideEffectAutoRepay)
+
+	assert.Equal(t, params{
+		"symbol": "BNBUSDT", "side": "SELL", "quantity": "0.1", "price": "400", "stopPrice": "300",
+		"stopLimitPrice": "290", "stopLimitTimeInForce": "GTC", "isIsolated": "TRUE", "sideEffectType": "AUTO_REPAY",
+	}, service.toParams())
+}
+
+func TestCreateMarginOTOOrderListService(t *testing.T) {
+	service := NewCreateMarginOTOOrderListService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BNBUSDT").
+		WithWorking(NewOrderListLeg(common.OrderTypeLimit).WithSide(common.OrderSideBuy).WithQuantity("1").WithPrice("300").WithTimeInForce(common.OrderTimeInForceGTC)).
+		WithPending(NewOrderListLeg(common.OrderTypeLimitMaker).WithSide(common.OrderSideSell).WithQuantity("1").WithPrice("400")).
+		WithIsIsolated(true).
+		WithSideEffectType(common.SideEffectMarginBuy).
+		WithAutoRepayAtCancel(false)
+
+	assert.Equal(t, params{
+		"symbol":      "BNBUSDT",
+		"workingType": "LIMIT", "workingSide": "BUY", "workingQuantity": "1", "workingPrice": "300", "workingTimeInForce": "GTC",
+		"pendingType": "LIMIT_MAKER", "pendingSide": "SELL", "pendingQuantity": "1", "pendingPrice": "400",
+		"isIsolated": "TRUE", "sideEffectType": "MARGIN_BUY", "autoRepayAtCancel": "false",
+	}, service.toParams())
+	assert.Equal(t, "/sapi/v1/margin/order/oto", service.SM.SD.Path)
+	assert.Equal(t, 6, service.SM.SD.WeightUID)
+}
+
+func TestCreateMarginOTOCOOrderListService(t *testing.T) {
+	service := NewCreateMarginOTOCOOrderListService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BNBUSDT").
+		WithWorking(NewOrderListLeg(common.OrderTypeLimit).WithSide(common.OrderSideBuy).WithQuantity("1").WithPrice("300").WithTimeInForce(common.OrderTimeInForceGTC)).
+		WithPendingSide(common.OrderSideSell).
+		WithPendingQuantity("1").
+		WithPendingAbove(NewOrderListLeg(common.OrderTypeLimitMaker).WithPrice("400")).
+		WithPendingBelow(NewOrderListLeg(common.OrderTypeStopLoss).WithStopPrice("250")).
+		WithSideEffectType(common.SideEffectNoSideEffect)
+
+	assert.Equal(t, params{
+		"symbol": "BNBUSDT", "pendingSide": "SELL", "pendingQuantity": "1",
+		"workingType": "LIMIT", "workingSide": "BUY", "workingQuantity": "1", "workingPrice": "300", "workingTimeInForce": "GTC",
+		"pendingAboveType": "LIMIT_MAKER", "pendingAbovePrice": "400",
+		"pendingBelowType": "STOP_LOSS", "pendingBelowStopPrice": "250",
+		"sideEffectType": "NO_SIDE_EFFECT",
+	}, service.toParams())
+	assert.Equal(t, "/sapi/v1/margin/order/otoco", service.SM.SD.Path)
+	assert.Equal(t, 6, service.SM.SD.WeightUID)
+}
+
+func TestOpenOrderListsService(t *testing.T) {
+	service := NewOpenMarginOrderListsService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("LTCBTC").
+		WithIsIsolated(true)
+	assert.Equal(t, params{"symbol": "LTCBTC", "isIsolated": "TRUE"}, service.toParams())
+
+	resp, err := service.parseResponse(apiOpenOrderListsData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.OrderLists, 1)
+	assert.Equal(t, int64(31), resp.OrderLists[0].OrderListID)
+	assert.True(t, resp.OrderLists[0].IsIsolated)
+	assert.Equal(t, int64(5), resp.OrderLists[0].Orders[1].OrderID)
+}
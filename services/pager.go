@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Pager ============================================ */
+
+// pageFunc fetches the next page of a Pager. It returns done = true once the
+// last page has been fetched. An empty page that is not done is skipped
+// (e.g. a time window without any aggregate trades).
+type pageFunc[T any] func(ctx context.Context) (page []T, done bool, err error)
+
+// Pager walks a range of historical market data in pages of at most one
+// request limit each.
+//
+// Requests are made through the RESTClient, so every page is weighted by the
+// rateLimitManager. If a request would exceed a rate limit, the Pager waits
+// until the rate limit interval resets and then retries the page. Rate limit
+// errors returned by the server (418, 429) are not retried.
+//
+// Usage:
+//
+//	pager := svc.Pager(startTime, endTime)
+//	for pager.Next(ctx) {
+//		for _, record := range pager.Page() { ... }
+//	}
+//	if err := pager.Err(); err != nil { ... }
+type Pager[T any] struct {
+	fetch pageFunc[T]
+	page  []T
+	done  bool
+	err   error
+}
+
+// newPager returns a new Pager that fetches its pages with fetch.
+func newPager[T any](fetch pageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// Next fetches the next page. It returns false once all pages have been
+// fetched, or if an error occurred (see Err).
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	p.page = nil
+	for !p.done && p.err == nil {
+		page, done, err := p.fetch(ctx)
+		if err != nil {
+			p.err = p.waitForRateLimit(ctx, err)
+			continue
+		}
+
+		p.done = done
+		if len(page) > 0 {
+			p.page = page
+			return true
+		}
+	}
+	return false
+}
+
+// Page returns the page that was fetched by the last call to Next.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Err returns the error that stopped the Pager, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// waitForRateLimit blocks until a request that was refused by the
+// rateLimitManager can be retried. It returns nil if the request should be
+// retried, and err otherwise.
+func (p *Pager[T]) waitForRateLimit(ctx context.Context, err error) error {
+	var rateLimitErr *common.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.Producer != "shrimpy-binance" {
+		return err
+	}
+
+	timer := time.NewTimer(time.Until(rateLimitErr.RetryTimeLocal))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
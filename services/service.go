@@ -114,237 +114,1951 @@ var (
 			WeightIP:            10,
 			WeightUID:           0,
 		},
-	}
 
-	SAPIServices = map[string]common.ServiceDefinition{
-		"systemStatus": {
+		"createOrder": {
 			Scheme:              "https",
-			Method:              http.MethodGet,
+			Method:              http.MethodPost,
 			Endpoint:            common.EndpointAPI,
-			Path:                "/sapi/v1/system/status",
-			EndpointType:        common.EndpointTypeSAPI,
-			SecurityType:        common.SecurityTypeNone,
-			PrimaryDatasource:   common.DataSourceNone,
+			Path:                "/api/v3/order",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
 			SecondaryDatasource: common.DataSourceNone,
 			WeightIP:            1,
-			WeightUID:           0,
+			WeightUID:           1,
 		},
 
-		"createListenKey": {
+		"testOrder": {
 			Scheme:              "https",
 			Method:              http.MethodPost,
 			Endpoint:            common.EndpointAPI,
-			Path:                "/sapi/v1/userDataStream",
-			EndpointType:        common.EndpointTypeSAPI,
-			SecurityType:        common.SecurityTypeApiKey,
-			PrimaryDatasource:   common.DataSourceNone,
+			Path:                "/api/v3/order/test",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
 			SecondaryDatasource: common.DataSourceNone,
 			WeightIP:            1,
 			WeightUID:           0,
 		},
 
-		"pingListenKey": {
+		"cancelOrder": {
 			Scheme:              "https",
-			Method:              http.MethodPut,
+			Method:              http.MethodDelete,
 			Endpoint:            common.EndpointAPI,
-			Path:                "/sapi/v1/userDataStream",
-			EndpointType:        common.EndpointTypeSAPI,
-			SecurityType:        common.SecurityTypeApiKey,
-			PrimaryDatasource:   common.DataSourceNone,
+			Path:                "/api/v3/order",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
 			SecondaryDatasource: common.DataSourceNone,
 			WeightIP:            1,
 			WeightUID:           0,
 		},
 
-		"closeListenKey": {
+		"cancelOpenOrders": {
 			Scheme:              "https",
 			Method:              http.MethodDelete,
 			Endpoint:            common.EndpointAPI,
-			Path:                "/sapi/v1/userDataStream",
-			EndpointType:        common.EndpointTypeSAPI,
-			SecurityType:        common.SecurityTypeApiKey,
-			PrimaryDatasource:   common.DataSourceNone,
+			Path:                "/api/v3/openOrders",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
 			SecondaryDatasource: common.DataSourceNone,
 			WeightIP:            1,
 			WeightUID:           0,
 		},
 
-		"createMarginOrder": {
+		"queryOrder": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/order",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+
+		"openOrders": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/openOrders",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            80,
+			WeightUID:           0,
+		},
+
+		"cancelReplaceOrder": {
 			Scheme:              "https",
 			Method:              http.MethodPost,
 			Endpoint:            common.EndpointAPI,
-			Path:                "/sapi/v1/margin/order",
-			EndpointType:        common.EndpointTypeSAPI,
+			Path:                "/api/v3/order/cancelReplace",
+			EndpointType:        common.EndpointTypeAPI,
 			SecurityType:        common.SecurityTypeSigned,
-			PrimaryDatasource:   common.DataSourceNone,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
 			SecondaryDatasource: common.DataSourceNone,
-			WeightIP:            0,
-			WeightUID:           6,
+			WeightIP:            1,
+			WeightUID:           1,
 		},
-	}
 
-	FAPIServices = map[string]common.ServiceDefinition{
-		"ping": {
+		"amendOrder": {
+			Scheme:              "https",
+			Method:              http.MethodPut,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/order/amend/keepPriority",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+
+		"createOCOOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/orderList/oco",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           2,
+		},
+
+		"createOTOOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/orderList/oto",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           2,
+		},
+
+		"createOTOCOOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/orderList/otoco",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           3,
+		},
+
+		"cancelOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/orderList",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMatchingEngine,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"queryOrderList": {
 			Scheme:              "https",
 			Method:              http.MethodGet,
-			Endpoint:            common.EndpointFAPI,
-			Path:                "/fapi/v1/ping",
-			EndpointType:        common.EndpointTypeFAPI,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/orderList",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+
+		"openOrderLists": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/openOrderList",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            6,
+			WeightUID:           0,
+		},
+
+		"account": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/account",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceDatabase,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"myTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/myTrades",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceDatabase,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"allOrders": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/allOrders",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"myPreventedMatches": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/myPreventedMatches",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"myAllocations": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/myAllocations",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"accountCommission": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/account/commission",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"orderRateLimit": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/rateLimit/order",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            40,
+			WeightUID:           0,
+		},
+
+		"klines": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/klines",
+			EndpointType:        common.EndpointTypeAPI,
 			SecurityType:        common.SecurityTypeNone,
-			PrimaryDatasource:   common.DataSourceNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
 			SecondaryDatasource: common.DataSourceNone,
-			WeightIP:            1,
+			WeightIP:            2,
 			WeightUID:           0,
 		},
 
-		"serverTime": {
+		"uiKlines": {
 			Scheme:              "https",
 			Method:              http.MethodGet,
-			Endpoint:            common.EndpointFAPI,
-			Path:                "/fapi/v1/time",
-			EndpointType:        common.EndpointTypeFAPI,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/uiKlines",
+			EndpointType:        common.EndpointTypeAPI,
 			SecurityType:        common.SecurityTypeNone,
-			PrimaryDatasource:   common.DataSourceNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
 			SecondaryDatasource: common.DataSourceNone,
-			WeightIP:            1,
+			WeightIP:            2,
+			WeightUID:           0,
+		},
+
+		"trades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/trades",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            25,
+			WeightUID:           0,
+		},
+
+		"historicalTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/historicalTrades",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            25,
+			WeightUID:           0,
+		},
+
+		"aggTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/aggTrades",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            2,
 			WeightUID:           0,
 		},
 
-		"depth1000": {
-			Scheme:              "https",
-			Method:              http.MethodGet,
-			Endpoint:            common.EndpointFAPI,
-			Path:                "/fapi/v1/depth",
-			EndpointType:        common.EndpointTypeFAPI,
-			SecurityType:        common.SecurityTypeNone,
-			PrimaryDatasource:   common.DataSourceNone,
-			SecondaryDatasource: common.DataSourceNone,
-			WeightIP:            20,
-			WeightUID:           0,
-		},
+		"avgPrice": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/avgPrice",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            2,
+			WeightUID:           0,
+		},
+
+		"ticker24hr": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/ticker/24hr",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            80,
+			WeightUID:           0,
+		},
+
+		"ticker": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/ticker",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+
+		"tickerTradingDay": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/ticker/tradingDay",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+
+		"tickerPrice": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/ticker/price",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+
+		"bookTicker": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/api/v3/ticker/bookTicker",
+			EndpointType:        common.EndpointTypeAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            4,
+			WeightUID:           0,
+		},
+	}
+
+	SAPIServices = map[string]common.ServiceDefinition{
+		"systemStatus": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/system/status",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"createListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/userDataStream",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"pingListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodPut,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/userDataStream",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"closeListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/userDataStream",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"createIsolatedListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/userDataStream/isolated",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"pingIsolatedListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodPut,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/userDataStream/isolated",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"closeIsolatedListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/userDataStream/isolated",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"createMarginOrder": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/order",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           6,
+		},
+
+		"createMarginOCOOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/order/oco",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           6,
+		},
+
+		"createMarginOTOOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/order/oto",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           6,
+		},
+
+		"createMarginOTOCOOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/order/otoco",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           6,
+		},
+
+		"cancelMarginOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/orderList",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           1,
+		},
+
+		"queryMarginOrderList": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/orderList",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"openMarginOrderLists": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/openOrderList",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"cancelMarginOrder": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/order",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           10,
+		},
+
+		"cancelMarginOpenOrders": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/openOrders",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"queryMarginOrder": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/order",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"openMarginOrders": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/openOrders",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"allMarginOrders": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/allOrders",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            200,
+			WeightUID:           0,
+		},
+
+		"marginTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/myTrades",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"marginOrderRateLimit": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/rateLimit/order",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"marginBorrowRepay": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/borrow-repay",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1500,
+			WeightUID:           0,
+		},
+
+		"marginMaxBorrowable": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/maxBorrowable",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            50,
+			WeightUID:           0,
+		},
+
+		"marginMaxTransferable": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/maxTransferable",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            50,
+			WeightUID:           0,
+		},
+
+		"marginAccount": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/account",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"isolatedMarginAccount": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/isolated/account",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"marginInterestHistory": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/interestHistory",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"marginTransfer": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/asset/transfer",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           900,
+		},
+
+		"marginTransferHistory": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointAPI,
+			Path:                "/sapi/v1/margin/transfer",
+			EndpointType:        common.EndpointTypeSAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+	}
+
+	FAPIServices = map[string]common.ServiceDefinition{
+		"ping": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/ping",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"serverTime": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/time",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"depth1000": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/depth",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"exchangeInfo": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/exchangeInfo",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"createListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/listenKey",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"pingListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodPut,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/listenKey",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"closeListenKey": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/listenKey",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"createOrder": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/order",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            0,
+			WeightUID:           1,
+		},
+
+		"createBatchOrders": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/batchOrders",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           5,
+		},
+
+		"modifyOrder": {
+			Scheme:              "https",
+			Method:              http.MethodPut,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/order",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           1,
+		},
+
+		"cancelOrder": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/order",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"cancelAllOpenOrders": {
+			Scheme:              "https",
+			Method:              http.MethodDelete,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/allOpenOrders",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"countdownCancelAll": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/countdownCancelAll",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"queryOrder": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/order",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"openOrders": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/openOrders",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            40,
+			WeightUID:           0,
+		},
+
+		"accountV2": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v2/account",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"accountV3": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v3/account",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"balance": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v3/balance",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"positionRisk": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v3/positionRisk",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"changeLeverage": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/leverage",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"changeMarginType": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/marginType",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"modifyPositionMargin": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/positionMargin",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"positionMode": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/positionSide/dual",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            30,
+			WeightUID:           0,
+		},
+
+		"changePositionMode": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/positionSide/dual",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"multiAssetsMode": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/multiAssetsMargin",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            30,
+			WeightUID:           0,
+		},
+
+		"changeMultiAssetsMode": {
+			Scheme:              "https",
+			Method:              http.MethodPost,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/multiAssetsMargin",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"leverageBracket": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/leverageBracket",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"commissionRate": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/commissionRate",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"incomeHistory": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/income",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            30,
+			WeightUID:           0,
+		},
+
+		"userTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/userTrades",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeSigned,
+			PrimaryDatasource:   common.DataSourceNone,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"klines": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/klines",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"trades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/trades",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"historicalTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/historicalTrades",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeApiKey,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"aggTrades": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/aggTrades",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            20,
+			WeightUID:           0,
+		},
+
+		"ticker24hr": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/ticker/24hr",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            40,
+			WeightUID:           0,
+		},
+
+		"tickerPrice": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v2/ticker/price",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            2,
+			WeightUID:           0,
+		},
+
+		"bookTicker": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/ticker/bookTicker",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            5,
+			WeightUID:           0,
+		},
+
+		"premiumIndex": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/premiumIndex",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            10,
+			WeightUID:           0,
+		},
+
+		"fundingRate": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/fundingRate",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"openInterest": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/fapi/v1/openInterest",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceMemory,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"openInterestHist": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/futures/data/openInterestHist",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"topLongShortAccountRatio": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/futures/data/topLongShortAccountRatio",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"topLongShortPositionRatio": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/futures/data/topLongShortPositionRatio",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"globalLongShortAccountRatio": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/futures/data/globalLongShortAccountRatio",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+
+		"takerLongShortRatio": {
+			Scheme:              "https",
+			Method:              http.MethodGet,
+			Endpoint:            common.EndpointFAPI,
+			Path:                "/futures/data/takerlongshortRatio",
+			EndpointType:        common.EndpointTypeFAPI,
+			SecurityType:        common.SecurityTypeNone,
+			PrimaryDatasource:   common.DataSourceDatabase,
+			SecondaryDatasource: common.DataSourceNone,
+			WeightIP:            1,
+			WeightUID:           0,
+		},
+	}
+)
+
+/* ==================== APIServices ====================================== */
+
+func NewSpotMarginPingService(rc common.RESTClient, logger *log.Entry) *PingService {
+	return &PingService{
+		SM:     *common.NewServiceMeta(APIServices["ping"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginPingService"),
+	}
+}
+
+func NewSpotMarginServerTimeService(rc common.RESTClient, logger *log.Entry) *ServerTimeService {
+	return &ServerTimeService{
+		SM:     *common.NewServiceMeta(APIServices["serverTime"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginServerTimeService"),
+	}
+}
+
+func NewSpotMarginDepth100Service(rc common.RESTClient, logger *log.Entry) *SpotMarginDepthService {
+	return &SpotMarginDepthService{
+		SM:     *common.NewServiceMeta(APIServices["depth100"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginDepth5000Service"),
+		depth:  100,
+	}
+}
+
+func NewSpotMarginDepth5000Service(rc common.RESTClient, logger *log.Entry) *SpotMarginDepthService {
+	return &SpotMarginDepthService{
+		SM:     *common.NewServiceMeta(APIServices["depth5000"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginDepth5000Service"),
+		depth:  5000,
+	}
+}
+
+func NewSpotCreateListenKeyService(rc common.RESTClient, logger *log.Entry) *CreateListenKeyService {
+	return &CreateListenKeyService{
+		SM:     *common.NewServiceMeta(APIServices["createListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotCreateListenKeyService"),
+	}
+}
+
+func NewSpotPingListenKeyService(rc common.RESTClient, logger *log.Entry) *PingListenKeyService {
+	return &PingListenKeyService{
+		SM:     *common.NewServiceMeta(APIServices["pingListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotPingListenKeyService"),
+	}
+}
+
+func NewSpotCloseListenKeyService(rc common.RESTClient, logger *log.Entry) *CloseListenKeyService {
+	return &CloseListenKeyService{
+		SM:     *common.NewServiceMeta(APIServices["closeListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotCloseListenKeyService"),
+	}
+}
+
+func NewSpotMarginExchangeInfoService(rc common.RESTClient, logger *log.Entry) *SpotMarginExchangeInfoService {
+	return &SpotMarginExchangeInfoService{
+		SM:     *common.NewServiceMeta(APIServices["exchangeInfo"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginExchangeInfoService"),
+	}
+}
+
+func NewCreateSpotOrderService(rc common.RESTClient, logger *log.Entry) *CreateSpotOrderService {
+	return &CreateSpotOrderService{
+		SM:     *common.NewServiceMeta(APIServices["createOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateSpotOrderService"),
+	}
+}
+
+func NewTestSpotOrderService(rc common.RESTClient, logger *log.Entry) *TestSpotOrderService {
+	return &TestSpotOrderService{
+		SM:     *common.NewServiceMeta(APIServices["testOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "TestSpotOrderService"),
+	}
+}
+
+func NewCancelSpotOrderService(rc common.RESTClient, logger *log.Entry) *CancelSpotOrderService {
+	return &CancelSpotOrderService{
+		SM:     *common.NewServiceMeta(APIServices["cancelOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelSpotOrderService"),
+	}
+}
+
+func NewCancelAllSpotOrdersService(rc common.RESTClient, logger *log.Entry) *CancelAllSpotOrdersService {
+	return &CancelAllSpotOrdersService{
+		SM:     *common.NewServiceMeta(APIServices["cancelOpenOrders"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelAllSpotOrdersService"),
+	}
+}
+
+func NewQuerySpotOrderService(rc common.RESTClient, logger *log.Entry) *QuerySpotOrderService {
+	return &QuerySpotOrderService{
+		SM:     *common.NewServiceMeta(APIServices["queryOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "QuerySpotOrderService"),
+	}
+}
+
+func NewOpenSpotOrdersService(rc common.RESTClient, logger *log.Entry) *OpenSpotOrdersService {
+	return &OpenSpotOrdersService{
+		SM:     *common.NewServiceMeta(APIServices["openOrders"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "OpenSpotOrdersService"),
+	}
+}
+
+func NewCancelReplaceSpotOrderService(rc common.RESTClient, logger *log.Entry) *CancelReplaceSpotOrderService {
+	return &CancelReplaceSpotOrderService{
+		SM:                *common.NewServiceMeta(APIServices["cancelReplaceOrder"]),
+		rc:                rc,
+		logger:            logger.WithField("_caller", "CancelReplaceSpotOrderService"),
+		cancelReplaceMode: string(common.CancelReplaceModeStopOnFailure),
+	}
+}
+
+func NewAmendSpotOrderService(rc common.RESTClient, logger *log.Entry) *AmendSpotOrderService {
+	return &AmendSpotOrderService{
+		SM:     *common.NewServiceMeta(APIServices["amendOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "AmendSpotOrderService"),
+	}
+}
+
+func NewCreateSpotOCOOrderListService(rc common.RESTClient, logger *log.Entry) *CreateSpotOCOOrderListService {
+	return &CreateSpotOCOOrderListService{
+		SM:     *common.NewServiceMeta(APIServices["createOCOOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateSpotOCOOrderListService"),
+	}
+}
+
+func NewCreateSpotOTOOrderListService(rc common.RESTClient, logger *log.Entry) *CreateSpotOTOOrderListService {
+	return &CreateSpotOTOOrderListService{
+		SM:     *common.NewServiceMeta(APIServices["createOTOOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateSpotOTOOrderListService"),
+	}
+}
+
+func NewCreateSpotOTOCOOrderListService(rc common.RESTClient, logger *log.Entry) *CreateSpotOTOCOOrderListService {
+	return &CreateSpotOTOCOOrderListService{
+		SM:     *common.NewServiceMeta(APIServices["createOTOCOOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateSpotOTOCOOrderListService"),
+	}
+}
+
+func NewCancelSpotOrderListService(rc common.RESTClient, logger *log.Entry) *CancelOrderListService {
+	return &CancelOrderListService{
+		SM:     *common.NewServiceMeta(APIServices["cancelOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelSpotOrderListService"),
+	}
+}
+
+func NewQuerySpotOrderListService(rc common.RESTClient, logger *log.Entry) *QueryOrderListService {
+	return &QueryOrderListService{
+		SM:     *common.NewServiceMeta(APIServices["queryOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "QuerySpotOrderListService"),
+	}
+}
+
+func NewOpenSpotOrderListsService(rc common.RESTClient, logger *log.Entry) *OpenOrderListsService {
+	return &OpenOrderListsService{
+		SM:     *common.NewServiceMeta(APIServices["openOrderLists"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "OpenSpotOrderListsService"),
+	}
+}
+
+func NewSpotAccountService(rc common.RESTClient, logger *log.Entry) *SpotAccountService {
+	return &SpotAccountService{
+		SM:     *common.NewServiceMeta(APIServices["account"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotAccountService"),
+	}
+}
+
+func NewSpotTradesService(rc common.RESTClient, logger *log.Entry) *SpotTradesService {
+	return &SpotTradesService{
+		SM:     *common.NewServiceMeta(APIServices["myTrades"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotTradesService"),
+	}
+}
+
+func NewAllSpotOrdersService(rc common.RESTClient, logger *log.Entry) *AllSpotOrdersService {
+	return &AllSpotOrdersService{
+		SM:     *common.NewServiceMeta(APIServices["allOrders"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "AllSpotOrdersService"),
+	}
+}
+
+func NewSpotPreventedMatchesService(rc common.RESTClient, logger *log.Entry) *SpotPreventedMatchesService {
+	return &SpotPreventedMatchesService{
+		SM:     *common.NewServiceMeta(APIServices["myPreventedMatches"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotPreventedMatchesService"),
+	}
+}
+
+func NewSpotAllocationsService(rc common.RESTClient, logger *log.Entry) *SpotAllocationsService {
+	return &SpotAllocationsService{
+		SM:     *common.NewServiceMeta(APIServices["myAllocations"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotAllocationsService"),
+	}
+}
+
+func NewSpotCommissionService(rc common.RESTClient, logger *log.Entry) *SpotCommissionService {
+	return &SpotCommissionService{
+		SM:     *common.NewServiceMeta(APIServices["accountCommission"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotCommissionService"),
+	}
+}
+
+func NewSpotOrderRateLimitService(rc common.RESTClient, logger *log.Entry) *SpotOrderRateLimitService {
+	return &SpotOrderRateLimitService{
+		SM:     *common.NewServiceMeta(APIServices["orderRateLimit"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotOrderRateLimitService"),
+	}
+}
+
+func NewSpotMarginKlinesService(rc common.RESTClient, logger *log.Entry) *KlinesService {
+	return &KlinesService{
+		SM:       *common.NewServiceMeta(APIServices["klines"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "SpotMarginKlinesService"),
+		maxLimit: 1000,
+	}
+}
+
+func NewSpotMarginUIKlinesService(rc common.RESTClient, logger *log.Entry) *KlinesService {
+	return &KlinesService{
+		SM:       *common.NewServiceMeta(APIServices["uiKlines"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "SpotMarginUIKlinesService"),
+		maxLimit: 1000,
+	}
+}
+
+func NewSpotMarginRecentTradesService(rc common.RESTClient, logger *log.Entry) *RecentTradesService {
+	return &RecentTradesService{
+		SM:     *common.NewServiceMeta(APIServices["trades"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginRecentTradesService"),
+	}
+}
+
+func NewSpotMarginHistoricalTradesService(rc common.RESTClient, logger *log.Entry) *HistoricalTradesService {
+	return &HistoricalTradesService{
+		SM:       *common.NewServiceMeta(APIServices["historicalTrades"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "SpotMarginHistoricalTradesService"),
+		maxLimit: 1000,
+	}
+}
+
+func NewSpotMarginAggTradesService(rc common.RESTClient, logger *log.Entry) *AggTradesService {
+	return &AggTradesService{
+		SM:       *common.NewServiceMeta(APIServices["aggTrades"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "SpotMarginAggTradesService"),
+		maxLimit: 1000,
+	}
+}
+
+func NewSpotMarginAvgPriceService(rc common.RESTClient, logger *log.Entry) *SpotMarginAvgPriceService {
+	return &SpotMarginAvgPriceService{
+		SM:     *common.NewServiceMeta(APIServices["avgPrice"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginAvgPriceService"),
+	}
+}
+
+func NewSpotMarginTicker24hrService(rc common.RESTClient, logger *log.Entry) *SpotMarginTicker24hrService {
+	return &SpotMarginTicker24hrService{
+		SM:     *common.NewServiceMeta(APIServices["ticker24hr"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginTicker24hrService"),
+	}
+}
+
+func NewSpotMarginRollingWindowTickerService(rc common.RESTClient, logger *log.Entry) *SpotMarginRollingWindowTickerService {
+	return &SpotMarginRollingWindowTickerService{
+		SM:     *common.NewServiceMeta(APIServices["ticker"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginRollingWindowTickerService"),
+	}
+}
+
+func NewSpotMarginTradingDayTickerService(rc common.RESTClient, logger *log.Entry) *SpotMarginTradingDayTickerService {
+	return &SpotMarginTradingDayTickerService{
+		SM:     *common.NewServiceMeta(APIServices["tickerTradingDay"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginTradingDayTickerService"),
+	}
+}
+
+func NewSpotMarginPriceTickerService(rc common.RESTClient, logger *log.Entry) *SpotMarginPriceTickerService {
+	return &SpotMarginPriceTickerService{
+		SM:     *common.NewServiceMeta(APIServices["tickerPrice"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginPriceTickerService"),
+	}
+}
+
+func NewSpotMarginBookTickerService(rc common.RESTClient, logger *log.Entry) *SpotMarginBookTickerService {
+	return &SpotMarginBookTickerService{
+		SM:     *common.NewServiceMeta(APIServices["bookTicker"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "SpotMarginBookTickerService"),
+	}
+}
+
+/* ==================== SAPIServices ===================================== */
+
+func NewMarginSystemStatusService(rc common.RESTClient, logger *log.Entry) *SystemStatusService {
+	return &SystemStatusService{
+		SM:     *common.NewServiceMeta(SAPIServices["systemStatus"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "MarginSystemStatusService"),
+	}
+}
+
+func NewMarginCreateListenKeyService(rc common.RESTClient, logger *log.Entry) *CreateListenKeyService {
+	return &CreateListenKeyService{
+		SM:     *common.NewServiceMeta(SAPIServices["createListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "MarginCreateListenKeyService"),
+	}
+}
+
+func NewMarginPingListenKeyService(rc common.RESTClient, logger *log.Entry) *PingListenKeyService {
+	return &PingListenKeyService{
+		SM:     *common.NewServiceMeta(SAPIServices["pingListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "MarginPingListenKeyService"),
+	}
+}
+
+func NewMarginCloseListenKeyService(rc common.RESTClient, logger *log.Entry) *CloseListenKeyService {
+	return &CloseListenKeyService{
+		SM:     *common.NewServiceMeta(SAPIServices["closeListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "MarginCloseListenKeyService"),
+	}
+}
+
+func NewIsolatedMarginCreateListenKeyService(rc common.RESTClient, logger *log.Entry) *CreateListenKeyService {
+	return &CreateListenKeyService{
+		SM:     *common.NewServiceMeta(SAPIServices["createIsolatedListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "IsolatedMarginCreateListenKeyService"),
+	}
+}
+
+func NewIsolatedMarginPingListenKeyService(rc common.RESTClient, logger *log.Entry) *PingListenKeyService {
+	return &PingListenKeyService{
+		SM:     *common.NewServiceMeta(SAPIServices["pingIsolatedListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "IsolatedMarginPingListenKeyService"),
+	}
+}
+
+func NewIsolatedMarginCloseListenKeyService(rc common.RESTClient, logger *log.Entry) *CloseListenKeyService {
+	return &CloseListenKeyService{
+		SM:     *common.NewServiceMeta(SAPIServices["closeIsolatedListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "IsolatedMarginCloseListenKeyService"),
+	}
+}
+
+func NewCreateMarginOrderService(rc common.RESTClient, logger *log.Entry) *CreateMarginOrderService {
+	return &CreateMarginOrderService{
+		SM:     *common.NewServiceMeta(SAPIServices["createMarginOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateMarginOrderService"),
+	}
+}
+
+func NewCreateMarginOCOOrderListService(rc common.RESTClient, logger *log.Entry) *CreateMarginOCOOrderListService {
+	return &CreateMarginOCOOrderListService{
+		SM:     *common.NewServiceMeta(SAPIServices["createMarginOCOOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateMarginOCOOrderListService"),
+	}
+}
+
+func NewCreateMarginOTOOrderListService(rc common.RESTClient, logger *log.Entry) *CreateMarginOTOOrderListService {
+	return &CreateMarginOTOOrderListService{
+		SM:     *common.NewServiceMeta(SAPIServices["createMarginOTOOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateMarginOTOOrderListService"),
+	}
+}
+
+func NewCreateMarginOTOCOOrderListService(rc common.RESTClient, logger *log.Entry) *CreateMarginOTOCOOrderListService {
+	return &CreateMarginOTOCOOrderListService{
+		SM:     *common.NewServiceMeta(SAPIServices["createMarginOTOCOOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateMarginOTOCOOrderListService"),
+	}
+}
+
+func NewCancelMarginOrderListService(rc common.RESTClient, logger *log.Entry) *CancelOrderListService {
+	return &CancelOrderListService{
+		SM:     *common.NewServiceMeta(SAPIServices["cancelMarginOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelMarginOrderListService"),
+	}
+}
+
+func NewQueryMarginOrderListService(rc common.RESTClient, logger *log.Entry) *QueryOrderListService {
+	return &QueryOrderListService{
+		SM:     *common.NewServiceMeta(SAPIServices["queryMarginOrderList"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "QueryMarginOrderListService"),
+	}
+}
 
-		"exchangeInfo": {
-			Scheme:              "https",
-			Method:              http.MethodGet,
-			Endpoint:            common.EndpointFAPI,
-			Path:                "/fapi/v1/exchangeInfo",
-			EndpointType:        common.EndpointTypeFAPI,
-			SecurityType:        common.SecurityTypeNone,
-			PrimaryDatasource:   common.DataSourceNone,
-			SecondaryDatasource: common.DataSourceNone,
-			WeightIP:            1,
-			WeightUID:           0,
-		},
+func NewOpenMarginOrderListsService(rc common.RESTClient, logger *log.Entry) *OpenOrderListsService {
+	return &OpenOrderListsService{
+		SM:     *common.NewServiceMeta(SAPIServices["openMarginOrderLists"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "OpenMarginOrderListsService"),
 	}
-)
+}
 
-/* ==================== APIServices ====================================== */
+func NewCancelMarginOrderService(rc common.RESTClient, logger *log.Entry) *CancelMarginOrderService {
+	return &CancelMarginOrderService{
+		SM:     *common.NewServiceMeta(SAPIServices["cancelMarginOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelMarginOrderService"),
+	}
+}
 
-func NewSpotMarginPingService(rc common.RESTClient, logger *log.Entry) *PingService {
-	return &PingService{
-		SM:     *common.NewServiceMeta(APIServices["ping"]),
+func NewCancelAllMarginOrdersService(rc common.RESTClient, logger *log.Entry) *CancelAllMarginOrdersService {
+	return &CancelAllMarginOrdersService{
+		SM:     *common.NewServiceMeta(SAPIServices["cancelMarginOpenOrders"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotMarginPingService"),
+		logger: logger.WithField("_caller", "CancelAllMarginOrdersService"),
 	}
 }
 
-func NewSpotMarginServerTimeService(rc common.RESTClient, logger *log.Entry) *ServerTimeService {
-	return &ServerTimeService{
-		SM:     *common.NewServiceMeta(APIServices["serverTime"]),
+func NewQueryMarginOrderService(rc common.RESTClient, logger *log.Entry) *QueryMarginOrderService {
+	return &QueryMarginOrderService{
+		SM:     *common.NewServiceMeta(SAPIServices["queryMarginOrder"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotMarginServerTimeService"),
+		logger: logger.WithField("_caller", "QueryMarginOrderService"),
 	}
 }
 
-func NewSpotMarginDepth100Service(rc common.RESTClient, logger *log.Entry) *SpotMarginDepthService {
-	return &SpotMarginDepthService{
-		SM:     *common.NewServiceMeta(APIServices["depth100"]),
+func NewOpenMarginOrdersService(rc common.RESTClient, logger *log.Entry) *OpenMarginOrdersService {
+	return &OpenMarginOrdersService{
+		SM:     *common.NewServiceMeta(SAPIServices["openMarginOrders"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotMarginDepth5000Service"),
-		depth:  100,
+		logger: logger.WithField("_caller", "OpenMarginOrdersService"),
 	}
 }
 
-func NewSpotMarginDepth5000Service(rc common.RESTClient, logger *log.Entry) *SpotMarginDepthService {
-	return &SpotMarginDepthService{
-		SM:     *common.NewServiceMeta(APIServices["depth5000"]),
+func NewAllMarginOrdersService(rc common.RESTClient, logger *log.Entry) *AllMarginOrdersService {
+	return &AllMarginOrdersService{
+		SM:     *common.NewServiceMeta(SAPIServices["allMarginOrders"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotMarginDepth5000Service"),
-		depth:  5000,
+		logger: logger.WithField("_caller", "AllMarginOrdersService"),
 	}
 }
 
-func NewSpotCreateListenKeyService(rc common.RESTClient, logger *log.Entry) *CreateListenKeyService {
-	return &CreateListenKeyService{
-		SM:     *common.NewServiceMeta(APIServices["createListenKey"]),
+func NewMarginTradesService(rc common.RESTClient, logger *log.Entry) *MarginTradesService {
+	return &MarginTradesService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginTrades"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotCreateListenKeyService"),
+		logger: logger.WithField("_caller", "MarginTradesService"),
 	}
 }
 
-func NewSpotPingListenKeyService(rc common.RESTClient, logger *log.Entry) *PingListenKeyService {
-	return &PingListenKeyService{
-		SM:     *common.NewServiceMeta(APIServices["pingListenKey"]),
+func NewMarginOrderRateLimitService(rc common.RESTClient, logger *log.Entry) *MarginOrderRateLimitService {
+	return &MarginOrderRateLimitService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginOrderRateLimit"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotPingListenKeyService"),
+		logger: logger.WithField("_caller", "MarginOrderRateLimitService"),
 	}
 }
 
-func NewSpotCloseListenKeyService(rc common.RESTClient, logger *log.Entry) *CloseListenKeyService {
-	return &CloseListenKeyService{
-		SM:     *common.NewServiceMeta(APIServices["closeListenKey"]),
+func NewMarginBorrowRepayService(rc common.RESTClient, logger *log.Entry) *MarginBorrowRepayService {
+	return &MarginBorrowRepayService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginBorrowRepay"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotCloseListenKeyService"),
+		logger: logger.WithField("_caller", "MarginBorrowRepayService"),
 	}
 }
 
-func NewSpotMarginExchangeInfoService(rc common.RESTClient, logger *log.Entry) *SpotMarginExchangeInfoService {
-	return &SpotMarginExchangeInfoService{
-		SM:     *common.NewServiceMeta(APIServices["exchangeInfo"]),
+func NewMarginMaxBorrowableService(rc common.RESTClient, logger *log.Entry) *MarginMaxBorrowableService {
+	return &MarginMaxBorrowableService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginMaxBorrowable"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "SpotMarginExchangeInfoService"),
+		logger: logger.WithField("_caller", "MarginMaxBorrowableService"),
 	}
 }
 
-/* ==================== SAPIServices ===================================== */
+func NewMarginMaxTransferableService(rc common.RESTClient, logger *log.Entry) *MarginMaxTransferableService {
+	return &MarginMaxTransferableService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginMaxTransferable"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "MarginMaxTransferableService"),
+	}
+}
 
-func NewMarginSystemStatusService(rc common.RESTClient, logger *log.Entry) *SystemStatusService {
-	return &SystemStatusService{
-		SM:     *common.NewServiceMeta(SAPIServices["systemStatus"]),
+func NewMarginAccountService(rc common.RESTClient, logger *log.Entry) *MarginAccountService {
+	return &MarginAccountService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginAccount"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "MarginSystemStatusService"),
+		logger: logger.WithField("_caller", "MarginAccountService"),
 	}
 }
 
-func NewMarginCreateListenKeyService(rc common.RESTClient, logger *log.Entry) *CreateListenKeyService {
-	return &CreateListenKeyService{
-		SM:     *common.NewServiceMeta(SAPIServices["createListenKey"]),
+func NewIsolatedMarginAccountService(rc common.RESTClient, logger *log.Entry) *IsolatedMarginAccountService {
+	return &IsolatedMarginAccountService{
+		SM:     *common.NewServiceMeta(SAPIServices["isolatedMarginAccount"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "MarginCreateListenKeyService"),
+		logger: logger.WithField("_caller", "IsolatedMarginAccountService"),
 	}
 }
 
-func NewMarginPingListenKeyService(rc common.RESTClient, logger *log.Entry) *PingListenKeyService {
-	return &PingListenKeyService{
-		SM:     *common.NewServiceMeta(SAPIServices["pingListenKey"]),
+func NewMarginInterestHistoryService(rc common.RESTClient, logger *log.Entry) *MarginInterestHistoryService {
+	return &MarginInterestHistoryService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginInterestHistory"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "MarginPingListenKeyService"),
+		logger: logger.WithField("_caller", "MarginInterestHistoryService"),
 	}
 }
 
-func NewMarginCloseListenKeyService(rc common.RESTClient, logger *log.Entry) *CloseListenKeyService {
-	return &CloseListenKeyService{
-		SM:     *common.NewServiceMeta(SAPIServices["closeListenKey"]),
+func NewMarginTransferService(rc common.RESTClient, logger *log.Entry) *MarginTransferService {
+	return &MarginTransferService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginTransfer"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "MarginCloseListenKeyService"),
+		logger: logger.WithField("_caller", "MarginTransferService"),
 	}
 }
 
-func NewCreateMarginOrderService(rc common.RESTClient, logger *log.Entry) *CreateMarginOrderService {
-	return &CreateMarginOrderService{
-		SM:     *common.NewServiceMeta(SAPIServices["createMarginOrder"]),
+func NewMarginTransferHistoryService(rc common.RESTClient, logger *log.Entry) *MarginTransferHistoryService {
+	return &MarginTransferHistoryService{
+		SM:     *common.NewServiceMeta(SAPIServices["marginTransferHistory"]),
 		rc:     rc,
-		logger: logger.WithField("_caller", "CreateMarginOrderService"),
+		logger: logger.WithField("_caller", "MarginTransferHistoryService"),
 	}
 }
 
@@ -382,3 +2096,334 @@ func NewFuturesExchangeInfoService(rc common.RESTClient, logger *log.Entry) *Fut
 		logger: logger.WithField("_caller", "FuturesExchangeInfoService"),
 	}
 }
+
+func NewFuturesCreateListenKeyService(rc common.RESTClient, logger *log.Entry) *CreateListenKeyService {
+	return &CreateListenKeyService{
+		SM:     *common.NewServiceMeta(FAPIServices["createListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesCreateListenKeyService"),
+	}
+}
+
+func NewFuturesPingListenKeyService(rc common.RESTClient, logger *log.Entry) *PingListenKeyService {
+	return &PingListenKeyService{
+		SM:     *common.NewServiceMeta(FAPIServices["pingListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesPingListenKeyService"),
+	}
+}
+
+func NewFuturesCloseListenKeyService(rc common.RESTClient, logger *log.Entry) *CloseListenKeyService {
+	return &CloseListenKeyService{
+		SM:     *common.NewServiceMeta(FAPIServices["closeListenKey"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesCloseListenKeyService"),
+	}
+}
+
+func NewCreateFuturesOrderService(rc common.RESTClient, logger *log.Entry) *CreateFuturesOrderService {
+	return &CreateFuturesOrderService{
+		SM:     *common.NewServiceMeta(FAPIServices["createOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateFuturesOrderService"),
+	}
+}
+
+func NewCreateFuturesBatchOrdersService(rc common.RESTClient, logger *log.Entry) *CreateFuturesBatchOrdersService {
+	return &CreateFuturesBatchOrdersService{
+		SM:     *common.NewServiceMeta(FAPIServices["createBatchOrders"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CreateFuturesBatchOrdersService"),
+	}
+}
+
+func NewModifyFuturesOrderService(rc common.RESTClient, logger *log.Entry) *ModifyFuturesOrderService {
+	return &ModifyFuturesOrderService{
+		SM:     *common.NewServiceMeta(FAPIServices["modifyOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "ModifyFuturesOrderService"),
+	}
+}
+
+func NewCancelFuturesOrderService(rc common.RESTClient, logger *log.Entry) *CancelFuturesOrderService {
+	return &CancelFuturesOrderService{
+		SM:     *common.NewServiceMeta(FAPIServices["cancelOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelFuturesOrderService"),
+	}
+}
+
+func NewCancelAllFuturesOrdersService(rc common.RESTClient, logger *log.Entry) *CancelAllFuturesOrdersService {
+	return &CancelAllFuturesOrdersService{
+		SM:     *common.NewServiceMeta(FAPIServices["cancelAllOpenOrders"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CancelAllFuturesOrdersService"),
+	}
+}
+
+func NewCountdownCancelAllFuturesService(rc common.RESTClient, logger *log.Entry) *CountdownCancelAllFuturesService {
+	return &CountdownCancelAllFuturesService{
+		SM:     *common.NewServiceMeta(FAPIServices["countdownCancelAll"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "CountdownCancelAllFuturesService"),
+	}
+}
+
+func NewQueryFuturesOrderService(rc common.RESTClient, logger *log.Entry) *QueryFuturesOrderService {
+	return &QueryFuturesOrderService{
+		SM:     *common.NewServiceMeta(FAPIServices["queryOrder"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "QueryFuturesOrderService"),
+	}
+}
+
+func NewOpenFuturesOrdersService(rc common.RESTClient, logger *log.Entry) *OpenFuturesOrdersService {
+	return &OpenFuturesOrdersService{
+		SM:     *common.NewServiceMeta(FAPIServices["openOrders"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "OpenFuturesOrdersService"),
+	}
+}
+
+func NewFuturesAccountV2Service(rc common.RESTClient, logger *log.Entry) *FuturesAccountService {
+	return &FuturesAccountService{
+		SM:     *common.NewServiceMeta(FAPIServices["accountV2"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesAccountV2Service"),
+	}
+}
+
+func NewFuturesAccountV3Service(rc common.RESTClient, logger *log.Entry) *FuturesAccountService {
+	return &FuturesAccountService{
+		SM:     *common.NewServiceMeta(FAPIServices["accountV3"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesAccountV3Service"),
+	}
+}
+
+func NewFuturesBalanceService(rc common.RESTClient, logger *log.Entry) *FuturesBalanceService {
+	return &FuturesBalanceService{
+		SM:     *common.NewServiceMeta(FAPIServices["balance"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesBalanceService"),
+	}
+}
+
+func NewFuturesPositionRiskService(rc common.RESTClient, logger *log.Entry) *FuturesPositionRiskService {
+	return &FuturesPositionRiskService{
+		SM:     *common.NewServiceMeta(FAPIServices["positionRisk"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesPositionRiskService"),
+	}
+}
+
+func NewChangeFuturesLeverageService(rc common.RESTClient, logger *log.Entry) *ChangeFuturesLeverageService {
+	return &ChangeFuturesLeverageService{
+		SM:     *common.NewServiceMeta(FAPIServices["changeLeverage"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "ChangeFuturesLeverageService"),
+	}
+}
+
+func NewChangeFuturesMarginTypeService(rc common.RESTClient, logger *log.Entry) *ChangeFuturesMarginTypeService {
+	return &ChangeFuturesMarginTypeService{
+		SM:     *common.NewServiceMeta(FAPIServices["changeMarginType"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "ChangeFuturesMarginTypeService"),
+	}
+}
+
+func NewModifyFuturesPositionMarginService(rc common.RESTClient, logger *log.Entry) *ModifyFuturesPositionMarginService {
+	return &ModifyFuturesPositionMarginService{
+		SM:     *common.NewServiceMeta(FAPIServices["modifyPositionMargin"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "ModifyFuturesPositionMarginService"),
+	}
+}
+
+func NewFuturesPositionModeService(rc common.RESTClient, logger *log.Entry) *FuturesPositionModeService {
+	return &FuturesPositionModeService{
+		SM:     *common.NewServiceMeta(FAPIServices["positionMode"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesPositionModeService"),
+	}
+}
+
+func NewChangeFuturesPositionModeService(rc common.RESTClient, logger *log.Entry) *ChangeFuturesPositionModeService {
+	return &ChangeFuturesPositionModeService{
+		SM:     *common.NewServiceMeta(FAPIServices["changePositionMode"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "ChangeFuturesPositionModeService"),
+	}
+}
+
+func NewFuturesMultiAssetsModeService(rc common.RESTClient, logger *log.Entry) *FuturesMultiAssetsModeService {
+	return &FuturesMultiAssetsModeService{
+		SM:     *common.NewServiceMeta(FAPIServices["multiAssetsMode"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesMultiAssetsModeService"),
+	}
+}
+
+func NewChangeFuturesMultiAssetsModeService(rc common.RESTClient, logger *log.Entry) *ChangeFuturesMultiAssetsModeService {
+	return &ChangeFuturesMultiAssetsModeService{
+		SM:     *common.NewServiceMeta(FAPIServices["changeMultiAssetsMode"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "ChangeFuturesMultiAssetsModeService"),
+	}
+}
+
+func NewFuturesLeverageBracketService(rc common.RESTClient, logger *log.Entry) *FuturesLeverageBracketService {
+	return &FuturesLeverageBracketService{
+		SM:     *common.NewServiceMeta(FAPIServices["leverageBracket"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesLeverageBracketService"),
+	}
+}
+
+func NewFuturesCommissionRateService(rc common.RESTClient, logger *log.Entry) *FuturesCommissionRateService {
+	return &FuturesCommissionRateService{
+		SM:     *common.NewServiceMeta(FAPIServices["commissionRate"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesCommissionRateService"),
+	}
+}
+
+func NewFuturesIncomeHistoryService(rc common.RESTClient, logger *log.Entry) *FuturesIncomeHistoryService {
+	return &FuturesIncomeHistoryService{
+		SM:     *common.NewServiceMeta(FAPIServices["incomeHistory"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesIncomeHistoryService"),
+	}
+}
+
+func NewFuturesTradesService(rc common.RESTClient, logger *log.Entry) *FuturesTradesService {
+	return &FuturesTradesService{
+		SM:     *common.NewServiceMeta(FAPIServices["userTrades"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesTradesService"),
+	}
+}
+
+func NewFuturesKlinesService(rc common.RESTClient, logger *log.Entry) *KlinesService {
+	return &KlinesService{
+		SM:       *common.NewServiceMeta(FAPIServices["klines"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "FuturesKlinesService"),
+		maxLimit: 1500,
+	}
+}
+
+func NewFuturesRecentTradesService(rc common.RESTClient, logger *log.Entry) *RecentTradesService {
+	return &RecentTradesService{
+		SM:     *common.NewServiceMeta(FAPIServices["trades"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesRecentTradesService"),
+	}
+}
+
+func NewFuturesHistoricalTradesService(rc common.RESTClient, logger *log.Entry) *HistoricalTradesService {
+	return &HistoricalTradesService{
+		SM:       *common.NewServiceMeta(FAPIServices["historicalTrades"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "FuturesHistoricalTradesService"),
+		maxLimit: 500,
+	}
+}
+
+func NewFuturesAggTradesService(rc common.RESTClient, logger *log.Entry) *AggTradesService {
+	return &AggTradesService{
+		SM:       *common.NewServiceMeta(FAPIServices["aggTrades"]),
+		rc:       rc,
+		logger:   logger.WithField("_caller", "FuturesAggTradesService"),
+		maxLimit: 1000,
+	}
+}
+
+func NewFuturesTicker24hrService(rc common.RESTClient, logger *log.Entry) *FuturesTicker24hrService {
+	return &FuturesTicker24hrService{
+		SM:     *common.NewServiceMeta(FAPIServices["ticker24hr"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesTicker24hrService"),
+	}
+}
+
+func NewFuturesPriceTickerService(rc common.RESTClient, logger *log.Entry) *FuturesPriceTickerService {
+	return &FuturesPriceTickerService{
+		SM:     *common.NewServiceMeta(FAPIServices["tickerPrice"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesPriceTickerService"),
+	}
+}
+
+func NewFuturesBookTickerService(rc common.RESTClient, logger *log.Entry) *FuturesBookTickerService {
+	return &FuturesBookTickerService{
+		SM:     *common.NewServiceMeta(FAPIServices["bookTicker"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesBookTickerService"),
+	}
+}
+
+func NewFuturesPremiumIndexService(rc common.RESTClient, logger *log.Entry) *FuturesPremiumIndexService {
+	return &FuturesPremiumIndexService{
+		SM:     *common.NewServiceMeta(FAPIServices["premiumIndex"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesPremiumIndexService"),
+	}
+}
+
+func NewFuturesFundingRateService(rc common.RESTClient, logger *log.Entry) *FuturesFundingRateService {
+	return &FuturesFundingRateService{
+		SM:     *common.NewServiceMeta(FAPIServices["fundingRate"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesFundingRateService"),
+	}
+}
+
+func NewFuturesOpenInterestService(rc common.RESTClient, logger *log.Entry) *FuturesOpenInterestService {
+	return &FuturesOpenInterestService{
+		SM:     *common.NewServiceMeta(FAPIServices["openInterest"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesOpenInterestService"),
+	}
+}
+
+func NewFuturesOpenInterestHistService(rc common.RESTClient, logger *log.Entry) *FuturesOpenInterestHistService {
+	return &FuturesOpenInterestHistService{
+		SM:     *common.NewServiceMeta(FAPIServices["openInterestHist"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesOpenInterestHistService"),
+	}
+}
+
+func NewFuturesTopLongShortAccountRatioService(rc common.RESTClient, logger *log.Entry) *FuturesLongShortRatioService {
+	return &FuturesLongShortRatioService{
+		SM:     *common.NewServiceMeta(FAPIServices["topLongShortAccountRatio"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesTopLongShortAccountRatioService"),
+	}
+}
+
+func NewFuturesTopLongShortPositionRatioService(rc common.RESTClient, logger *log.Entry) *FuturesLongShortRatioService {
+	return &FuturesLongShortRatioService{
+		SM:     *common.NewServiceMeta(FAPIServices["topLongShortPositionRatio"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesTopLongShortPositionRatioService"),
+	}
+}
+
+func NewFuturesGlobalLongShortAccountRatioService(rc common.RESTClient, logger *log.Entry) *FuturesLongShortRatioService {
+	return &FuturesLongShortRatioService{
+		SM:     *common.NewServiceMeta(FAPIServices["globalLongShortAccountRatio"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesGlobalLongShortAccountRatioService"),
+	}
+}
+
+func NewFuturesTakerLongShortRatioService(rc common.RESTClient, logger *log.Entry) *FuturesTakerLongShortRatioService {
+	return &FuturesTakerLongShortRatioService{
+		SM:     *common.NewServiceMeta(FAPIServices["takerLongShortRatio"]),
+		rc:     rc,
+		logger: logger.WithField("_caller", "FuturesTakerLongShortRatioService"),
+	}
+}
@@ -0,0 +1,653 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== SpotAccountService =============================== */
+
+// SpotBalance is the balance of an asset of a spot account.
+type SpotBalance struct {
+	Asset  string `json:"asset"`
+	Free   string `json:"free"`
+	Locked string `json:"locked"`
+}
+
+// SpotAccountResponse is the response of a SpotAccountService.
+type SpotAccountResponse struct {
+	ServiceBaseResponse
+	MakerCommission            int                                   `json:"makerCommission"`
+	TakerCommission            int                                   `json:"takerCommission"`
+	BuyerCommission            int                                   `json:"buyerCommission"`
+	SellerCommission           int                                   `json:"sellerCommission"`
+	CommissionRates            CommissionRates                       `json:"commissionRates"`
+	CanTrade                   bool                                  `json:"canTrade"`
+	CanWithdraw                bool                                  `json:"canWithdraw"`
+	CanDeposit                 bool                                  `json:"canDeposit"`
+	Brokered                   bool                                  `json:"brokered"`
+	RequireSelfTradePrevention bool                                  `json:"requireSelfTradePrevention"`
+	PreventSor                 bool                                  `json:"preventSor"`
+	TSSUpdate                  common.TSNano                         `json:"updateTime"`
+	AccountType                string                                `json:"accountType"`
+	Balances                   []SpotBalance                         `json:"balances"`
+	Permissions                []common.BIAccountAndSymbolPermission `json:"permissions"`
+	UID                        int64                                 `json:"uid"`
+}
+
+// SpotAccountService gets the current spot account information.
+type SpotAccountService struct {
+	SM               common.ServiceMeta
+	rc               common.RESTClient
+	logger           *log.Entry
+	omitZeroBalances *string // (default: false)
+}
+
+// Do sends the request and returns a SpotAccountResponse.
+func (s *SpotAccountService) Do(ctx context.Context) (*SpotAccountResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotAccountService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("omitZeroBalances", s.omitZeroBalances)
+	return p
+}
+
+func (s *SpotAccountService) parseResponse(data []byte) (*SpotAccountResponse, error) {
+	resp := &SpotAccountResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithOmitZeroBalances returns a copy of the service with omitZeroBalances
+// set to the given value.
+func (s SpotAccountService) WithOmitZeroBalances(omitZeroBalances bool) *SpotAccountService {
+	omitZeroBalancesStr := strconv.FormatBool(omitZeroBalances)
+	s.omitZeroBalances = &omitZeroBalancesStr
+	return &s
+}
+
+/* ==================== SpotTradesService ================================ */
+
+// SpotTrade is a trade of the spot account.
+type SpotTrade struct {
+	Symbol          string        `json:"symbol"`
+	ID              int64         `json:"id"`
+	OrderID         int64         `json:"orderId"`
+	OrderListID     int64         `json:"orderListId"` // -1 unless part of an order list
+	Price           string        `json:"price"`
+	Qty             string        `json:"qty"`
+	QuoteQty        string        `json:"quoteQty"`
+	Commission      string        `json:"commission"`
+	CommissionAsset string        `json:"commissionAsset"`
+	TSSTrade        common.TSNano `json:"time"`
+	IsBuyer         bool          `json:"isBuyer"`
+	IsMaker         bool          `json:"isMaker"`
+	IsBestMatch     bool          `json:"isBestMatch"`
+}
+
+// SpotTradesResponse is the response of a SpotTradesService.
+type SpotTradesResponse struct {
+	ServiceBaseResponse
+	Trades []SpotTrade
+}
+
+// SpotTradesService gets the trades of the spot account on a symbol.
+// Trades can be paged through either by fromId or by startTime and endTime
+// (at most 24 hours apart).
+type SpotTradesService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	orderID    *string
+	startTime  *string
+	endTime    *string
+	fromID     *string // if set, trades >= fromId are returned
+	limit      *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns a SpotTradesResponse.
+func (s *SpotTradesService) Do(ctx context.Context) (*SpotTradesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotTradesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("fromId", s.fromID)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *SpotTradesService) parseResponse(data []byte) (*SpotTradesResponse, error) {
+	resp := &SpotTradesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Trades); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotTradesService) WithSymbolREST(symbolREST string) *SpotTradesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given
+// value. Querying the trades of a single order reduces the request weight
+// from 20 to 5.
+func (s SpotTradesService) WithOrderID(orderID int64) *SpotTradesService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	s.SM.SD.WeightIP = 5
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s SpotTradesService) WithStartTime(startTime common.TSNano) *SpotTradesService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s SpotTradesService) WithEndTime(endTime common.TSNano) *SpotTradesService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithFromID returns a copy of the service with fromID set to the given value.
+func (s SpotTradesService) WithFromID(fromID int64) *SpotTradesService {
+	fromIDStr := strconv.FormatInt(fromID, 10)
+	s.fromID = &fromIDStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s SpotTradesService) WithLimit(limit int) *SpotTradesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== AllSpotOrdersService ============================= */
+
+// AllSpotOrdersResponse is the response of an AllSpotOrdersService.
+type AllSpotOrdersResponse struct {
+	ServiceBaseResponse
+	Orders []SpotOrderDetails
+}
+
+// AllSpotOrdersService gets all spot orders (active, canceled or filled) on
+// a symbol.
+type AllSpotOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+	orderID    *string // if set, orders >= orderId are returned
+	startTime  *string
+	endTime    *string
+	limit      *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns an AllSpotOrdersResponse.
+func (s *AllSpotOrdersService) Do(ctx context.Context) (*AllSpotOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *AllSpotOrdersService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *AllSpotOrdersService) parseResponse(data []byte) (*AllSpotOrdersResponse, error) {
+	resp := &AllSpotOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Orders); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s AllSpotOrdersService) WithSymbolREST(symbolREST string) *AllSpotOrdersService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s AllSpotOrdersService) WithOrderID(orderID int64) *AllSpotOrdersService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s AllSpotOrdersService) WithStartTime(startTime common.TSNano) *AllSpotOrdersService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s AllSpotOrdersService) WithEndTime(endTime common.TSNano) *AllSpotOrdersService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s AllSpotOrdersService) WithLimit(limit int) *AllSpotOrdersService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== SpotPreventedMatchesService ====================== */
+
+// SpotPreventedMatch is an order that expired due to self-trade prevention.
+type SpotPreventedMatch struct {
+	Symbol                  string                           `json:"symbol"`
+	PreventedMatchID        int64                            `json:"preventedMatchId"`
+	TakerOrderID            int64                            `json:"takerOrderId"`
+	MakerSymbol             string                           `json:"makerSymbol"`
+	MakerOrderID            int64                            `json:"makerOrderId"`
+	TradeGroupID            int64                            `json:"tradeGroupId"`
+	SelfTradePreventionMode common.BISelfTradePreventionMode `json:"selfTradePreventionMode"`
+	Price                   string                           `json:"price"`
+	MakerPreventedQuantity  string                           `json:"makerPreventedQuantity"`
+	TSSTransact             common.TSNano                    `json:"transactTime"`
+}
+
+// SpotPreventedMatchesResponse is the response of a
+// SpotPreventedMatchesService.
+type SpotPreventedMatchesResponse struct {
+	ServiceBaseResponse
+	PreventedMatches []SpotPreventedMatch
+}
+
+// SpotPreventedMatchesService gets the orders of a symbol that expired due
+// to self-trade prevention. (symbolREST and either preventedMatchId or
+// orderId must be sent)
+type SpotPreventedMatchesService struct {
+	SM                   common.ServiceMeta
+	rc                   common.RESTClient
+	logger               *log.Entry
+	symbolREST           string
+	preventedMatchID     *string
+	orderID              *string
+	fromPreventedMatchID *string // (only with orderId)
+	limit                *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns a SpotPreventedMatchesResponse.
+func (s *SpotPreventedMatchesService) Do(ctx context.Context) (*SpotPreventedMatchesResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotPreventedMatchesService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("preventedMatchId", s.preventedMatchID)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("fromPreventedMatchId", s.fromPreventedMatchID)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *SpotPreventedMatchesService) parseResponse(data []byte) (*SpotPreventedMatchesResponse, error) {
+	resp := &SpotPreventedMatchesResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.PreventedMatches); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotPreventedMatchesService) WithSymbolREST(symbolREST string) *SpotPreventedMatchesService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithPreventedMatchID returns a copy of the service with preventedMatchID
+// set to the given value. Querying by preventedMatchId reduces the request
+// weight from 20 to 2.
+func (s SpotPreventedMatchesService) WithPreventedMatchID(preventedMatchID int64) *SpotPreventedMatchesService {
+	preventedMatchIDStr := strconv.FormatInt(preventedMatchID, 10)
+	s.preventedMatchID = &preventedMatchIDStr
+	s.SM.SD.WeightIP = 2
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s SpotPreventedMatchesService) WithOrderID(orderID int64) *SpotPreventedMatchesService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithFromPreventedMatchID returns a copy of the service with
+// fromPreventedMatchID set to the given value.
+func (s SpotPreventedMatchesService) WithFromPreventedMatchID(fromPreventedMatchID int64) *SpotPreventedMatchesService {
+	fromPreventedMatchIDStr := strconv.FormatInt(fromPreventedMatchID, 10)
+	s.fromPreventedMatchID = &fromPreventedMatchIDStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s SpotPreventedMatchesService) WithLimit(limit int) *SpotPreventedMatchesService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== SpotAllocationsService =========================== */
+
+// SpotAllocation is an allocation resulting from an order placed through
+// the smart order routing (SOR).
+type SpotAllocation struct {
+	Symbol          string        `json:"symbol"`
+	AllocationID    int64         `json:"allocationId"`
+	AllocationType  string        `json:"allocationType"` // (SOR)
+	OrderID         int64         `json:"orderId"`
+	OrderListID     int64         `json:"orderListId"`
+	Price           string        `json:"price"`
+	Qty             string        `json:"qty"`
+	QuoteQty        string        `json:"quoteQty"`
+	Commission      string        `json:"commission"`
+	CommissionAsset string        `json:"commissionAsset"`
+	TSSAllocation   common.TSNano `json:"time"`
+	IsBuyer         bool          `json:"isBuyer"`
+	IsMaker         bool          `json:"isMaker"`
+	IsAllocator     bool          `json:"isAllocator"`
+}
+
+// SpotAllocationsResponse is the response of a SpotAllocationsService.
+type SpotAllocationsResponse struct {
+	ServiceBaseResponse
+	Allocations []SpotAllocation
+}
+
+// SpotAllocationsService gets the allocations of the spot account on a
+// symbol.
+type SpotAllocationsService struct {
+	SM               common.ServiceMeta
+	rc               common.RESTClient
+	logger           *log.Entry
+	symbolREST       string
+	orderID          *string
+	startTime        *string
+	endTime          *string
+	fromAllocationID *string
+	limit            *string // (default: 500, max: 1000)
+}
+
+// Do sends the request and returns a SpotAllocationsResponse.
+func (s *SpotAllocationsService) Do(ctx context.Context) (*SpotAllocationsResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotAllocationsService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("startTime", s.startTime)
+	p.SetIfNotNil("endTime", s.endTime)
+	p.SetIfNotNil("fromAllocationId", s.fromAllocationID)
+	p.SetIfNotNil("limit", s.limit)
+	return p
+}
+
+func (s *SpotAllocationsService) parseResponse(data []byte) (*SpotAllocationsResponse, error) {
+	resp := &SpotAllocationsResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Allocations); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotAllocationsService) WithSymbolREST(symbolREST string) *SpotAllocationsService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s SpotAllocationsService) WithOrderID(orderID int64) *SpotAllocationsService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithStartTime returns a copy of the service with startTime set to the given value.
+func (s SpotAllocationsService) WithStartTime(startTime common.TSNano) *SpotAllocationsService {
+	startTimeStr := tsNanoToMilliStr(startTime)
+	s.startTime = &startTimeStr
+	return &s
+}
+
+// WithEndTime returns a copy of the service with endTime set to the given value.
+func (s SpotAllocationsService) WithEndTime(endTime common.TSNano) *SpotAllocationsService {
+	endTimeStr := tsNanoToMilliStr(endTime)
+	s.endTime = &endTimeStr
+	return &s
+}
+
+// WithFromAllocationID returns a copy of the service with fromAllocationID
+// set to the given value.
+func (s SpotAllocationsService) WithFromAllocationID(fromAllocationID int64) *SpotAllocationsService {
+	fromAllocationIDStr := strconv.FormatInt(fromAllocationID, 10)
+	s.fromAllocationID = &fromAllocationIDStr
+	return &s
+}
+
+// WithLimit returns a copy of the service with limit set to the given value.
+func (s SpotAllocationsService) WithLimit(limit int) *SpotAllocationsService {
+	limitStr := strconv.Itoa(limit)
+	s.limit = &limitStr
+	return &s
+}
+
+/* ==================== SpotCommissionService ============================ */
+
+// SpotCommissionResponse is the response of a SpotCommissionService.
+type SpotCommissionResponse struct {
+	ServiceBaseResponse
+	Symbol             string             `json:"symbol"`
+	StandardCommission CommissionRates    `json:"standardCommission"`
+	TaxCommission      CommissionRates    `json:"taxCommission"`
+	Discount           CommissionDiscount `json:"discount"`
+}
+
+// SpotCommissionService gets the current account commission rates of a
+// symbol.
+type SpotCommissionService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+}
+
+// Do sends the request and returns a SpotCommissionResponse.
+func (s *SpotCommissionService) Do(ctx context.Context) (*SpotCommissionResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotCommissionService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	return p
+}
+
+func (s *SpotCommissionService) parseResponse(data []byte) (*SpotCommissionResponse, error) {
+	resp := &SpotCommissionResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotCommissionService) WithSymbolREST(symbolREST string) *SpotCommissionService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+/* ==================== SpotOrderRateLimitService ======================== */
+
+// SpotOrderRateLimitService gets the current unfilled order count of the
+// spot account for all intervals.
+type SpotOrderRateLimitService struct {
+	SM     common.ServiceMeta
+	rc     common.RESTClient
+	logger *log.Entry
+}
+
+// Do sends the request and returns an OrderRateLimitResponse.
+func (s *SpotOrderRateLimitService) Do(ctx context.Context) (*OrderRateLimitResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseOrderRateLimitResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	updateOrderRateLimitsUsed(s.rc, &s.SM, resp)
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotOrderRateLimitService) toParams() params {
+	return params{}
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiSpotAccountData = []byte(`{
+  "makerCommission": 15,
+  "takerCommission": 15,
+  "buyerCommission": 0,
+  "sellerCommission": 0,
+  "commissionRates": {
+    "maker": "0.00150000",
+    "taker": "0.00150000",
+    "buyer": "0.00000000",
+    "seller": "0.00000000"
+  },
+  "canTrade": true,
+  "canWithdraw": true,
+  "canDeposit": true,
+  "brokered": false,
+  "requireSelfTradePrevention": false,
+  "preventSor": false,
+  "updateTime": 123456789,
+  "accountType": "SPOT",
+  "balances": [
+    {"asset": "BTC", "free": "4723846.89208129", "locked": "0.00000000"},
+    {"asset": "LTC", "free": "4763368.68006011", "locked": "0.00000000"}
+  ],
+  "permissions": ["SPOT"],
+  "uid": 354937868
+}`)
+
+	apiSpotTradesData = []byte(`[
+  {
+    "symbol": "BNBBTC",
+    "id": 28457,
+    "orderId": 100234,
+    "orderListId": -1,
+    "price": "4.00000100",
+    "qty": "12.00000000",
+    "quoteQty": "48.000012",
+    "commission": "10.10000000",
+    "commissionAsset": "BNB",
+    "time": 1499865549590,
+    "isBuyer": true,
+    "isMaker": false,
+    "isBestMatch": true
+  }
+]`)
+
+	apiSpotPreventedMatchesData = []byte(`[
+  {
+    "symbol": "BTCUSDT",
+    "preventedMatchId": 1,
+    "takerOrderId": 5,
+    "makerSymbol": "BTCUSDT",
+    "makerOrderId": 3,
+    "tradeGroupId": 1,
+    "selfTradePreventionMode": "EXPIRE_MAKER",
+    "price": "1.100000",
+    "makerPreventedQuantity": "1.300000",
+    "transactTime": 1669101687094
+  }
+]`)
+
+	apiSpotCommissionData = []byte(`{
+  "symbol": "BTCUSDT",
+  "standardCommission": {"maker": "0.00000010", "taker": "0.00000020", "buyer": "0.00000030", "seller": "0.00000040"},
+  "taxCommission": {"maker": "0.00000112", "taker": "0.00000114", "buyer": "0.00000118", "seller": "0.00000116"},
+  "discount": {
+    "enabledForAccount": true,
+    "enabledForSymbol": true,
+    "discountAsset": "BNB",
+    "discount": "0.75000000"
+  }
+}`)
+)
+
+func TestSpotAccountService(t *testing.T) {
+	service := NewSpotAccountService(nil, log.NewEntry(log.New())).
+		WithOmitZeroBalances(true)
+	assert.Equal(t, params{"omitZeroBalances": "true"}, service.toParams())
+
+	resp, err := service.parseResponse(apiSpotAccountData)
+	assert.Nil(t, err)
+	assert.Equal(t, 15, resp.MakerCommission)
+	assert.Equal(t, "0.00150000", resp.CommissionRates.Taker)
+	assert.Equal(t, "0.00000000", resp.CommissionRates.Seller)
+	assert.Len(t, resp.Balances, 2)
+	assert.Equal(t, "LTC", resp.Balances[1].Asset)
+	assert.Equal(t, []common.BIAccountAndSymbolPermission{"SPOT"}, resp.Permissions)
+	assert.Equal(t, int64(354937868), resp.UID)
+}
+
+func TestSpotTradesService(t *testing.T) {
+	service := NewSpotTradesService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BNBBTC").
+		WithFromID(28457).
+		WithLimit(100)
+	assert.Equal(t, params{"symbol": "BNBBTC", "fromId": "28457", "limit": "100"}, service.toParams())
+	assert.Equal(t, 20, service.SM.SD.WeightIP)
+	assert.Equal(t, 5, service.WithOrderID(100234).SM.SD.WeightIP)
+
+	resp, err := service.parseResponse(apiSpotTradesData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Trades, 1)
+	assert.Equal(t, int64(28457), resp.Trades[0].ID)
+	assert.Equal(t, int64(-1), resp.Trades[0].OrderListID)
+	assert.Equal(t, "48.000012", resp.Trades[0].QuoteQty)
+	assert.Equal(t, common.NewTSNano(1499865549590), resp.Trades[0].TSSTrade)
+}
+
+func TestSpotPreventedMatchesService(t *testing.T) {
+	service := NewSpotPreventedMatchesService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithOrderID(5)
+	assert.Equal(t, params{"symbol": "BTCUSDT", "orderId": "5"}, service.toParams())
+	assert.Equal(t, 20, service.SM.SD.WeightIP)
+	assert.Equal(t, 2, service.WithPreventedMatchID(1).SM.SD.WeightIP)
+
+	resp, err := service.parseResponse(apiSpotPreventedMatchesData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.PreventedMatches, 1)
+	assert.Equal(t, int64(3), resp.PreventedMatches[0].MakerOrderID)
+	assert.Equal(t, common.SelfTradePreventionModeExpireMaker, resp.PreventedMatches[0].SelfTradePreventionMode)
+	assert.Equal(t, common.NewTSNano(1669101687094), resp.PreventedMatches[0].TSSTransact)
+}
+
+func TestSpotCommissionService(t *testing.T) {
+	service := NewSpotCommissionService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT")
+	assert.Equal(t, params{"symbol": "BTCUSDT"}, service.toParams())
+
+	resp, err := service.parseResponse(apiSpotCommissionData)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.00000020", resp.StandardCommission.Taker)
+	assert.Equal(t, "0.00000116", resp.TaxCommission.Seller)
+	assert.True(t, resp.Discount.EnabledForSymbol)
+	assert.Equal(t, "BNB", resp.Discount.DiscountAsset)
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== CancelReplaceSpotOrderService ==================== */
+
+// CancelReplaceCancelResponse is the cancel result of a cancel-replace order.
+// Code and Msg are only set if the cancel failed.
+type CancelReplaceCancelResponse struct {
+	SpotCanceledOrder
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// CancelReplaceNewOrderResponse is the new order result of a cancel-replace
+// order. Code and Msg are only set if the new order failed.
+type CancelReplaceNewOrderResponse struct {
+	SpotNewOrder
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// CancelReplaceSpotOrderResponse is the response of a
+// CancelReplaceSpotOrderService. CancelResponse and NewOrderResponse are nil
+// if the respective request was not attempted.
+type CancelReplaceSpotOrderResponse struct {
+	ServiceBaseResponse
+	CancelResult     common.BICancelReplaceResult   `json:"cancelResult"`
+	NewOrderResult   common.BICancelReplaceResult   `json:"newOrderResult"`
+	CancelResponse   *CancelReplaceCancelResponse   `json:"cancelResponse"`
+	NewOrderResponse *CancelReplaceNewOrderResponse `json:"newOrderResponse"`
+}
+
+// CancelReplaceSpotOrderService cancels an existing spot order and places a
+// new order on the same symbol. The new order is set with WithNewOrder.
+// (either cancelOrderId or cancelOrigClientOrderId must be sent)
+type CancelReplaceSpotOrderService struct {
+	SM                         common.ServiceMeta
+	rc                         common.RESTClient
+	logger                     *log.Entry
+	newOrder                   *CreateSpotOrderService
+	cancelReplaceMode          string  // (STOP_ON_FAILURE, ALLOW_FAILURE)
+	cancelOrderID              *string // (either cancelOrderId or cancelOrigClientOrderId)
+	cancelOrigClientOrderID    *string // (either cancelOrderId or cancelOrigClientOrderId)
+	cancelNewClientOrderID     *string
+	cancelRestrictions         *string // (ONLY_NEW, ONLY_PARTIALLY_FILLED)
+	orderRateLimitExceededMode *string // (DO_NOTHING, CANCEL_ONLY) (default: DO_NOTHING)
+}
+
+// Do sends the request and returns a CancelReplaceSpotOrderResponse.
+// If the cancel or the new order failed, Do returns both the response
+// (holding the results of both requests) and the error.
+func (s *CancelReplaceSpotOrderService) Do(ctx context.Context) (*CancelReplaceSpotOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+
+		// partial failures hold the results of both requests in the error's data.
+		if errData := errorData(err); errData != nil {
+			if resp, parseErr := s.parseResponse(errData); parseErr == nil {
+				return resp, err
+			}
+		}
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelReplaceSpotOrderService) toParams() params {
+	p := params{}
+	if s.newOrder != nil {
+		p = s.newOrder.toParams()
+	}
+	p.Set("cancelReplaceMode", s.cancelReplaceMode)
+	p.SetIfNotNil("cancelOrderId", s.cancelOrderID)
+	p.SetIfNotNil("cancelOrigClientOrderId", s.cancelOrigClientOrderID)
+	p.SetIfNotNil("cancelNewClientOrderId", s.cancelNewClientOrderID)
+	p.SetIfNotNil("cancelRestrictions", s.cancelRestrictions)
+	p.SetIfNotNil("orderRateLimitExceededMode", s.orderRateLimitExceededMode)
+	return p
+}
+
+func (s *CancelReplaceSpotOrderService) parseResponse(data []byte) (*CancelReplaceSpotOrderResponse, error) {
+	resp := &CancelReplaceSpotOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithNewOrder returns a copy of the service with the new order set to the
+// given CreateSpotOrderService's parameters.
+func (s CancelReplaceSpotOrderService) WithNewOrder(newOrder *CreateSpotOrderService) *CancelReplaceSpotOrderService {
+	s.newOrder = newOrder
+	return &s
+}
+
+// WithCancelReplaceMode returns a copy of the service with cancelReplaceMode
+// set to the given value.
+func (s CancelReplaceSpotOrderService) WithCancelReplaceMode(cancelReplaceMode common.BICancelReplaceMode) *CancelReplaceSpotOrderService {
+	s.cancelReplaceMode = string(cancelReplaceMode)
+	return &s
+}
+
+// WithCancelOrderID returns a copy of the service with cancelOrderID set to
+// the given value.
+func (s CancelReplaceSpotOrderService) WithCancelOrderID(cancelOrderID int64) *CancelReplaceSpotOrderService {
+	cancelOrderIDStr := strconv.FormatInt(cancelOrderID, 10)
+	s.cancelOrderID = &cancelOrderIDStr
+	return &s
+}
+
+// WithCancelOrigClientOrderID returns a copy of the service with
+// cancelOrigClientOrderID set to the given value.
+func (s CancelReplaceSpotOrderService) WithCancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceSpotOrderService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return &s
+}
+
+// WithCancelNewClientOrderID returns a copy of the service with
+// cancelNewClientOrderID set to the given value.
+func (s CancelReplaceSpotOrderService) WithCancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceSpotOrderService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return &s
+}
+
+// WithCancelRestrictions returns a copy of the service with cancelRestrictions
+// set to the given value.
+func (s CancelReplaceSpotOrderService) WithCancelRestrictions(cancelRestrictions common.BICancelRestriction) *CancelReplaceSpotOrderService {
+	cancelRestrictionsStr := string(cancelRestrictions)
+	s.cancelRestrictions = &cancelRestrictionsStr
+	return &s
+}
+
+// WithOrderRateLimitExceededMode returns a copy of the service with
+// orderRateLimitExceededMode set to the given value.
+func (s CancelReplaceSpotOrderService) WithOrderRateLimitExceededMode(orderRateLimitExceededMode common.BIOrderRateLimitExceededMode) *CancelReplaceSpotOrderService {
+	orderRateLimitExceededModeStr := string(orderRateLimitExceededMode)
+	s.orderRateLimitExceededMode = &orderRateLimitExceededModeStr
+	return &s
+}
+
+/* ==================== AmendSpotOrderService ============================ */
+
+// SpotAmendedOrder is a spot order that was amended.
+type SpotAmendedOrder struct {
+	Symbol                  string                           `json:"symbol"`
+	OrderID                 int64                            `json:"orderId"`
+	OrderListID             int64                            `json:"orderListId"` // -1 unless part of an order list
+	OrigClientOrderID       string                           `json:"origClientOrderId"`
+	ClientOrderID           string                           `json:"clientOrderId"`
+	Price                   string                           `json:"price"`
+	Qty                     string                           `json:"qty"`
+	ExecutedQty             string                           `json:"executedQty"`
+	PreventedQty            string                           `json:"preventedQty"`
+	QuoteOrderQty           string                           `json:"quoteOrderQty"`
+	CumQuoteQty             string                           `json:"cumulativeQuoteQty"`
+	Status                  common.BIOrderStatus             `json:"status"`
+	TimeInForce             common.BIOrderTimeInForce        `json:"timeInForce"`
+	OrderType               common.BIOrderType               `json:"type"`
+	Side                    common.BIOrderSide               `json:"side"`
+	TSSWorking              common.TSNano                    `json:"workingTime"`
+	SelfTradePreventionMode common.BISelfTradePreventionMode `json:"selfTradePreventionMode"`
+}
+
+// AmendSpotOrderResponse is the response of an AmendSpotOrderService.
+type AmendSpotOrderResponse struct {
+	ServiceBaseResponse
+	TSSTransact  common.TSNano    `json:"transactTime"`
+	ExecutionID  int64            `json:"executionId"`
+	AmendedOrder SpotAmendedOrder `json:"amendedOrder"`
+	ListStatus   *OrderList       `json:"listStatus"` // only for orders that are part of an order list
+}
+
+// AmendSpotOrderService reduces the quantity of an existing open spot order,
+// while keeping its priority in the order book.
+// (symbolREST, newQty and either orderId or origClientOrderId must be sent)
+type AmendSpotOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	newQty            string
+	orderID           *string
+	origClientOrderID *string
+	newClientOrderID  *string
+}
+
+// Do sends the request and returns an AmendSpotOrderResponse.
+func (s *AmendSpotOrderService) Do(ctx context.Context) (*AmendSpotOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *AmendSpotOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("newQty", s.newQty)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	p.SetIfNotNil("newClientOrderId", s.newClientOrderID)
+	return p
+}
+
+func (s *AmendSpotOrderService) parseResponse(data []byte) (*AmendSpotOrderResponse, error) {
+	resp := &AmendSpotOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s AmendSpotOrderService) WithSymbolREST(symbolREST string) *AmendSpotOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithNewQty returns a copy of the service with newQty set to the given
+// value. newQty must be greater than 0 and less than the order's quantity.
+func (s AmendSpotOrderService) WithNewQty(newQty string) *AmendSpotOrderService {
+	s.newQty = newQty
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s AmendSpotOrderService) WithOrderID(orderID int64) *AmendSpotOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s AmendSpotOrderService) WithOrigClientOrderID(origClientOrderID string) *AmendSpotOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+// WithNewClientOrderID returns a copy of the service with newClientOrderID
+// set to the given value.
+func (s AmendSpotOrderService) WithNewClientOrderID(newClientOrderID string) *AmendSpotOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return &s
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+type mockErrRestClient struct {
+	err error
+}
+
+func (m *mockErrRestClient) Do(ctx context.Context, sm *common.ServiceMeta, p url.Values) ([]byte, error) {
+	return nil, m.err
+}
+
+var (
+	apiCancelReplacePartialFailureData = []byte(`{
+  "cancelResult": "SUCCESS",
+  "newOrderResult": "FAILURE",
+  "cancelResponse": {
+    "symbol": "BTCUSDT",
+    "origClientOrderId": "86M8erehfExV8z2RC8Zo8k",
+    "orderId": 3,
+    "orderListId": -1,
+    "clientOrderId": "G1kLo6aDv2KGNTFcjfTSFq",
+    "transactTime": 1684804350068,
+    "price": "0.01000010",
+    "origQty": "0.000100",
+    "executedQty": "0.00000000",
+    "cummulativeQuoteQty": "0.00000000",
+    "status": "CANCELED",
+    "timeInForce": "GTC",
+    "type": "LIMIT_MAKER",
+    "side": "SELL",
+    "selfTradePreventionMode": "NONE"
+  },
+  "newOrderResponse": {
+    "code": -2010,
+    "msg": "Order would immediately match and take."
+  }
+}`)
+
+	apiAmendOrderData = []byte(`{
+  "transactTime": 1741926410255,
+  "executionId": 75,
+  "amendedOrder": {
+    "symbol": "BTCUSDT",
+    "orderId": 33,
+    "orderListId": -1,
+    "origClientOrderId": "5xrgbMyg6z36NzBn2pbT8H",
+    "clientOrderId": "PFaq6hIHxqFENGfdtn4J6Q",
+    "price": "6.00000000",
+    "qty": "5.00000000",
+    "executedQty": "0.00000000",
+    "preventedQty": "0.00000000",
+    "quoteOrderQty": "0.00000000",
+    "cumulativeQuoteQty": "0.00000000",
+    "status": "NEW",
+    "timeInForce": "GTC",
+    "type": "LIMIT",
+    "side": "SELL",
+    "workingTime": 1741926410242,
+    "selfTradePreventionMode": "NONE"
+  }
+}`)
+)
+
+func TestCancelReplaceSpotOrderService(t *testing.T) {
+	newOrder := (&CreateSpotOrderService{}).WithLimitOrderParams("BTCUSDT", common.OrderSideSell, "0.0001", "0.0100001", common.OrderTimeInForceGTC)
+	service := NewCancelReplaceSpotOrderService(nil, log.NewEntry(log.New())).
+		WithNewOrder(newOrder).
+		WithCancelOrderID(3).
+		WithCancelRestrictions(common.CancelRestrictionOnlyNew)
+
+	assert.Equal(t, params{
+		"symbol": "BTCUSDT", "side": "SELL", "type": "LIMIT", "quantity": "0.0001", "price": "0.0100001",
+		"timeInForce": "GTC", "cancelReplaceMode": "STOP_ON_FAILURE", "cancelOrderId": "3",
+		"cancelRestrictions": "ONLY_NEW",
+	}, service.toParams())
+
+	// partial failures return both the response and the error
+	reqErr := &common.UnexpectedStatusCodeError{
+		StatusCode: 409, ErrorCode: -2021, Msg: "Order cancel-replace partially failed.", Data: apiCancelReplacePartialFailureData,
+	}
+	service.rc = &mockErrRestClient{err: reqErr}
+
+	resp, err := service.Do(context.Background())
+	assert.Equal(t, reqErr, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, common.CancelReplaceResultSuccess, resp.CancelResult)
+	assert.Equal(t, common.CancelReplaceResultFailure, resp.NewOrderResult)
+	assert.Equal(t, int64(3), resp.CancelResponse.OrderID)
+	assert.Equal(t, common.OrderStatusCanceled, resp.CancelResponse.Status)
+	assert.Equal(t, -2010, resp.NewOrderResponse.Code)
+	assert.Equal(t, "Order would immediately match and take.", resp.NewOrderResponse.Msg)
+
+	// errors without data return no response
+	service.rc = &mockErrRestClient{err: &common.BadRequestError{StatusCode: 400, ErrorCode: -1102}}
+	resp, err = service.Do(context.Background())
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestAmendSpotOrderService(t *testing.T) {
+	service := NewAmendSpotOrderService(nil, log.NewEntry(log.New())).
+		WithSymbolREST("BTCUSDT").
+		WithOrderID(33).
+		WithNewQty("5")
+	assert.Equal(t, params{"symbol": "BTCUSDT", "orderId": "33", "newQty": "5"}, service.toParams())
+
+	resp, err := service.parseResponse(apiAmendOrderData)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(75), resp.ExecutionID)
+	assert.Equal(t, "5.00000000", resp.AmendedOrder.Qty)
+	assert.Equal(t, "5xrgbMyg6z36NzBn2pbT8H", resp.AmendedOrder.OrigClientOrderID)
+	assert.Equal(t, common.NewTSNano(1741926410242), resp.AmendedOrder.TSSWorking)
+	assert.Nil(t, resp.ListStatus)
+}
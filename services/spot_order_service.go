@@ -0,0 +1,671 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== SpotOrder ======================================== */
+
+// SpotOrder holds the order fields that are shared between all spot order
+// responses.
+type SpotOrder struct {
+	Symbol                  string                           `json:"symbol"`
+	OrderID                 int64                            `json:"orderId"`
+	OrderListID             int64                            `json:"orderListId"` // -1 unless part of an order list
+	ClientOrderID           string                           `json:"clientOrderId"`
+	Price                   string                           `json:"price"`
+	StopPrice               string                           `json:"stopPrice"`
+	IcebergQty              string                           `json:"icebergQty"`
+	OrigQty                 string                           `json:"origQty"`
+	ExecutedQty             string                           `json:"executedQty"`
+	CumQuoteQty             string                           `json:"cummulativeQuoteQty"`
+	OrigQuoteOrderQty       string                           `json:"origQuoteOrderQty"`
+	Status                  common.BIOrderStatus             `json:"status"`
+	TimeInForce             common.BIOrderTimeInForce        `json:"timeInForce"`
+	OrderType               common.BIOrderType               `json:"type"`
+	Side                    common.BIOrderSide               `json:"side"`
+	TSSWorking              common.TSNano                    `json:"workingTime"`
+	SelfTradePreventionMode common.BISelfTradePreventionMode `json:"selfTradePreventionMode"`
+}
+
+// SpotOrderFill is a fill of a FULL CreateSpotOrderResponse.
+type SpotOrderFill struct {
+	Price           string `json:"price"`
+	Qty             string `json:"qty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+	TradeID         int64  `json:"tradeId"`
+}
+
+// SpotOrderDetails is an order as returned by the query order and open
+// orders endpoints.
+type SpotOrderDetails struct {
+	SpotOrder
+	TSSCreated common.TSNano `json:"time"`
+	TSSUpdate  common.TSNano `json:"updateTime"`
+	IsWorking  bool          `json:"isWorking"`
+}
+
+/* ==================== CreateSpotOrderService =========================== */
+
+// CreateSpotOrderResponse is the response of a CreateSpotOrderService.
+// Which fields are set depends on the newOrderRespType of the request:
+// ACK only sets Symbol, OrderID, OrderListID, ClientOrderID and TSSTransact,
+// RESULT sets all fields but Fills, and FULL sets all fields.
+type CreateSpotOrderResponse struct {
+	ServiceBaseResponse
+	SpotNewOrder
+}
+
+// SpotNewOrder is a newly created spot order.
+type SpotNewOrder struct {
+	SpotOrder
+	TSSTransact common.TSNano   `json:"transactTime"`
+	Fills       []SpotOrderFill `json:"fills"`
+}
+
+// CreateSpotOrderService creates a new spot order.
+type CreateSpotOrderService struct {
+	SM                      common.ServiceMeta
+	rc                      common.RESTClient
+	logger                  *log.Entry
+	symbolREST              string  // (ALL ORDERS)
+	side                    string  // (ALL ORDERS)
+	orderType               string  // (ALL ORDERS)
+	price                   *string // (LIMIT, STOP_LOSS_LIMIT, TAKE_PROFIT_LIMIT, LIMIT_MAKER)
+	stopPrice               *string // (STOP_LOSS, TAKE_PROFIT, STOP_LOSS_LIMIT, TAKE_PROFIT_LIMIT)
+	trailingDelta           *string // (STOP_LOSS, TAKE_PROFIT, STOP_LOSS_LIMIT, TAKE_PROFIT_LIMIT)
+	quantity                *string // (ALL ORDERS)
+	quoteOrderQty           *string // (MARKET)
+	icebergQty              *string // (LIMIT, STOP_LOSS_LIMIT, TAKE_PROFIT_LIMIT)
+	selfTradePreventionMode *string // (EXPIRE_TAKER, EXPIRE_MAKER, EXPIRE_BOTH, NONE)
+	timeInForce             *string // (LIMIT, STOP_LOSS_LIMIT, TAKE_PROFIT_LIMIT)
+	newClientOrderID        *string // (ALL ORDERS)
+	newOrderRespType        *string // (ACK, RESULT, FULL) (default: FULL for MARKET and LIMIT, ACK otherwise)
+}
+
+// Do sends the request and returns a CreateSpotOrderResponse.
+func (s *CreateSpotOrderService) Do(ctx context.Context) (*CreateSpotOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CreateSpotOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.Set("side", s.side)
+	p.Set("type", s.orderType)
+
+	p.SetIfNotNil("price", s.price)
+	p.SetIfNotNil("stopPrice", s.stopPrice)
+	p.SetIfNotNil("trailingDelta", s.trailingDelta)
+
+	p.SetIfNotNil("quantity", s.quantity)
+	p.SetIfNotNil("quoteOrderQty", s.quoteOrderQty)
+	p.SetIfNotNil("icebergQty", s.icebergQty)
+
+	p.SetIfNotNil("selfTradePreventionMode", s.selfTradePreventionMode)
+	p.SetIfNotNil("timeInForce", s.timeInForce)
+	p.SetIfNotNil("newClientOrderId", s.newClientOrderID)
+	p.SetIfNotNil("newOrderRespType", s.newOrderRespType)
+
+	return p
+}
+
+func (s *CreateSpotOrderService) parseResponse(data []byte) (*CreateSpotOrderResponse, error) {
+	resp := &CreateSpotOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithBaseOrderParams returns a copy of the service with all parameters that
+// are mandatory and shared between all order types set.
+func (s CreateSpotOrderService) WithBaseOrderParams(
+	symbolREST string, side common.BIOrderSide, orderType common.BIOrderType,
+) *CreateSpotOrderService {
+	return s.WithSymbolREST(symbolREST).WithSide(side).WithOrderType(orderType)
+}
+
+// WithMarketOrderParams returns a copy of the service with all parameters that
+// are mandatory for a market order set.
+// Any optional params must be set before or after calling this method.
+func (s CreateSpotOrderService) WithMarketOrderParams(
+	symbolREST string, side common.BIOrderSide, quantity string,
+) *CreateSpotOrderService {
+	return s.
+		WithBaseOrderParams(symbolREST, side, common.OrderTypeMarket).
+		WithQuantity(quantity)
+}
+
+// WithLimitOrderParams returns a copy of the service with all parameters that
+// are mandatory for a limit order set.
+// Any optional params must be set before or after calling this method.
+func (s CreateSpotOrderService) WithLimitOrderParams(
+	symbolREST string,
+	side common.BIOrderSide,
+	quantity,
+	price string,
+	timeInForce common.BIOrderTimeInForce,
+) *CreateSpotOrderService {
+	return s.
+		WithBaseOrderParams(symbolREST, side, common.OrderTypeLimit).
+		WithQuantity(quantity).
+		WithPrice(price).
+		WithTimeInForce(timeInForce)
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CreateSpotOrderService) WithSymbolREST(symbolREST string) *CreateSpotOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithSide returns a copy of the service with side set to the given value.
+func (s CreateSpotOrderService) WithSide(side common.BIOrderSide) *CreateSpotOrderService {
+	s.side = string(side)
+	return &s
+}
+
+// WithOrderType returns a copy of the service with orderType set to the given value.
+func (s CreateSpotOrderService) WithOrderType(orderType common.BIOrderType) *CreateSpotOrderService {
+	s.orderType = string(orderType)
+	return &s
+}
+
+// WithPrice returns a copy of the service with price set to the given value.
+func (s CreateSpotOrderService) WithPrice(price string) *CreateSpotOrderService {
+	s.price = &price
+	return &s
+}
+
+// WithStopPrice returns a copy of the service with stopPrice set to the given value.
+func (s CreateSpotOrderService) WithStopPrice(stopPrice string) *CreateSpotOrderService {
+	s.stopPrice = &stopPrice
+	return &s
+}
+
+// WithTrailingDelta returns a copy of the service with trailingDelta (in BIPS)
+// set to the given value.
+func (s CreateSpotOrderService) WithTrailingDelta(trailingDelta int64) *CreateSpotOrderService {
+	trailingDeltaStr := strconv.FormatInt(trailingDelta, 10)
+	s.trailingDelta = &trailingDeltaStr
+	return &s
+}
+
+// WithQuantity returns a copy of the service with quantity set to the given value.
+func (s CreateSpotOrderService) WithQuantity(quantity string) *CreateSpotOrderService {
+	s.quantity = &quantity
+	return &s
+}
+
+// WithQuoteOrderQty returns a copy of the service with quoteOrderQty set to the given value.
+func (s CreateSpotOrderService) WithQuoteOrderQty(quoteOrderQty string) *CreateSpotOrderService {
+	s.quoteOrderQty = &quoteOrderQty
+	return &s
+}
+
+// WithIcebergQty returns a copy of the service with icebergQty set to the given value.
+func (s CreateSpotOrderService) WithIcebergQty(icebergQty string) *CreateSpotOrderService {
+	s.icebergQty = &icebergQty
+	return &s
+}
+
+// WithSelfTradePreventionMode returns a copy of the service with selfTradePreventionMode set to the given value.
+func (s CreateSpotOrderService) WithSelfTradePreventionMode(selfTradePreventionMode common.BISelfTradePreventionMode) *CreateSpotOrderService {
+	selfTradePreventionModeStr := string(selfTradePreventionMode)
+	s.selfTradePreventionMode = &selfTradePreventionModeStr
+	return &s
+}
+
+// WithTimeInForce returns a copy of the service with timeInForce set to the given value.
+func (s CreateSpotOrderService) WithTimeInForce(timeInForce common.BIOrderTimeInForce) *CreateSpotOrderService {
+	timeInForceStr := string(timeInForce)
+	s.timeInForce = &timeInForceStr
+	return &s
+}
+
+// WithNewClientOrderId returns a copy of the service with newClientOrderId
+// set to the given value.
+func (s CreateSpotOrderService) WithNewClientOrderId(newClientOrderId string) *CreateSpotOrderService {
+	s.newClientOrderID = &newClientOrderId
+	return &s
+}
+
+// WithNewOrderRespType returns a copy of the service with newOrderRespType
+// set to the given value.
+func (s CreateSpotOrderService) WithNewOrderRespType(newOrderRespType common.BIOrderResponseType) *CreateSpotOrderService {
+	newOrderRespTypeStr := string(newOrderRespType)
+	s.newOrderRespType = &newOrderRespTypeStr
+	return &s
+}
+
+/* ==================== TestSpotOrderService ============================= */
+
+// CommissionRates holds maker and taker commission rates. Buyer and Seller
+// are only set by the account endpoints.
+type CommissionRates struct {
+	Maker  string `json:"maker"`
+	Taker  string `json:"taker"`
+	Buyer  string `json:"buyer"`
+	Seller string `json:"seller"`
+}
+
+// CommissionDiscount holds the commission discount of an account.
+type CommissionDiscount struct {
+	EnabledForAccount bool   `json:"enabledForAccount"`
+	EnabledForSymbol  bool   `json:"enabledForSymbol"`
+	DiscountAsset     string `json:"discountAsset"`
+	Discount          string `json:"discount"` // discount rate when commissions are paid in discountAsset
+}
+
+// TestSpotOrderResponse is the response of a TestSpotOrderService.
+// The commission fields are only set if computeCommissionRates is true.
+type TestSpotOrderResponse struct {
+	ServiceBaseResponse
+	StandardCommissionForOrder *CommissionRates    `json:"standardCommissionForOrder"`
+	TaxCommissionForOrder      *CommissionRates    `json:"taxCommissionForOrder"`
+	Discount                   *CommissionDiscount `json:"discount"`
+}
+
+// TestSpotOrderService validates a new spot order without sending it to the
+// matching engine. The order to test is set with WithOrder.
+type TestSpotOrderService struct {
+	SM                     common.ServiceMeta
+	rc                     common.RESTClient
+	logger                 *log.Entry
+	order                  *CreateSpotOrderService
+	computeCommissionRates *string
+}
+
+// Do sends the request and returns a TestSpotOrderResponse.
+func (s *TestSpotOrderService) Do(ctx context.Context) (*TestSpotOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *TestSpotOrderService) toParams() params {
+	p := params{}
+	if s.order != nil {
+		p = s.order.toParams()
+	}
+	p.SetIfNotNil("computeCommissionRates", s.computeCommissionRates)
+	return p
+}
+
+func (s *TestSpotOrderService) parseResponse(data []byte) (*TestSpotOrderResponse, error) {
+	resp := &TestSpotOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithOrder returns a copy of the service with the order to test set to the
+// given CreateSpotOrderService's parameters.
+func (s TestSpotOrderService) WithOrder(order *CreateSpotOrderService) *TestSpotOrderService {
+	s.order = order
+	return &s
+}
+
+// WithComputeCommissionRates returns a copy of the service with
+// computeCommissionRates set to the given value. Computing commission rates
+// increases the request weight from 1 to 20.
+func (s TestSpotOrderService) WithComputeCommissionRates(computeCommissionRates bool) *TestSpotOrderService {
+	computeCommissionRatesStr := strconv.FormatBool(computeCommissionRates)
+	s.computeCommissionRates = &computeCommissionRatesStr
+	s.SM.SD.WeightIP = 1
+	if computeCommissionRates {
+		s.SM.SD.WeightIP = 20
+	}
+	return &s
+}
+
+/* ==================== CancelSpotOrderService =========================== */
+
+// CancelSpotOrderResponse is the response of a CancelSpotOrderService.
+type CancelSpotOrderResponse struct {
+	ServiceBaseResponse
+	SpotCanceledOrder
+}
+
+// SpotCanceledOrder is a canceled spot order.
+type SpotCanceledOrder struct {
+	SpotOrder
+	OrigClientOrderID string        `json:"origClientOrderId"`
+	TSSTransact       common.TSNano `json:"transactTime"`
+}
+
+// CancelSpotOrderService cancels an active spot order.
+// (symbolREST and either orderId or origClientOrderId must be sent)
+type CancelSpotOrderService struct {
+	SM                 common.ServiceMeta
+	rc                 common.RESTClient
+	logger             *log.Entry
+	symbolREST         string
+	orderID            *string
+	origClientOrderID  *string
+	newClientOrderID   *string
+	cancelRestrictions *string
+}
+
+// Do sends the request and returns a CancelSpotOrderResponse.
+func (s *CancelSpotOrderService) Do(ctx context.Context) (*CancelSpotOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelSpotOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	p.SetIfNotNil("newClientOrderId", s.newClientOrderID)
+	p.SetIfNotNil("cancelRestrictions", s.cancelRestrictions)
+	return p
+}
+
+func (s *CancelSpotOrderService) parseResponse(data []byte) (*CancelSpotOrderResponse, error) {
+	resp := &CancelSpotOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelSpotOrderService) WithSymbolREST(symbolREST string) *CancelSpotOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s CancelSpotOrderService) WithOrderID(orderID int64) *CancelSpotOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s CancelSpotOrderService) WithOrigClientOrderID(origClientOrderID string) *CancelSpotOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+// WithNewClientOrderID returns a copy of the service with newClientOrderID
+// set to the given value.
+func (s CancelSpotOrderService) WithNewClientOrderID(newClientOrderID string) *CancelSpotOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return &s
+}
+
+// WithCancelRestrictions returns a copy of the service with cancelRestrictions
+// set to the given value.
+func (s CancelSpotOrderService) WithCancelRestrictions(cancelRestrictions common.BICancelRestriction) *CancelSpotOrderService {
+	cancelRestrictionsStr := string(cancelRestrictions)
+	s.cancelRestrictions = &cancelRestrictionsStr
+	return &s
+}
+
+/* ==================== CancelAllSpotOrdersService ======================= */
+
+// CancelAllSpotOrdersResponse is the response of a CancelAllSpotOrdersService.
+// Orders that are part of an order list are returned in OrderLists.
+type CancelAllSpotOrdersResponse struct {
+	ServiceBaseResponse
+	Orders     []SpotCanceledOrder
+	OrderLists []OrderListReport
+}
+
+// CancelAllSpotOrdersService cancels all active orders (including order
+// lists) on a symbol.
+type CancelAllSpotOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+}
+
+// Do sends the request and returns a CancelAllSpotOrdersResponse.
+func (s *CancelAllSpotOrdersService) Do(ctx context.Context) (*CancelAllSpotOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *CancelAllSpotOrdersService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	return p
+}
+
+func (s *CancelAllSpotOrdersService) parseResponse(data []byte) (*CancelAllSpotOrdersResponse, error) {
+	resp := &CancelAllSpotOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	orders, orderLists, err := splitOrdersAndOrderLists[SpotCanceledOrder](data)
+	if err != nil {
+		return nil, err
+	}
+	resp.Orders, resp.OrderLists = orders, orderLists
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s CancelAllSpotOrdersService) WithSymbolREST(symbolREST string) *CancelAllSpotOrdersService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+/* ==================== QuerySpotOrderService ============================ */
+
+// QuerySpotOrderResponse is the response of a QuerySpotOrderService.
+type QuerySpotOrderResponse struct {
+	ServiceBaseResponse
+	SpotOrderDetails
+}
+
+// QuerySpotOrderService checks a spot order's status.
+// (symbolREST and either orderId or origClientOrderId must be sent)
+type QuerySpotOrderService struct {
+	SM                common.ServiceMeta
+	rc                common.RESTClient
+	logger            *log.Entry
+	symbolREST        string
+	orderID           *string
+	origClientOrderID *string
+}
+
+// Do sends the request and returns a QuerySpotOrderResponse.
+func (s *QuerySpotOrderService) Do(ctx context.Context) (*QuerySpotOrderResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *QuerySpotOrderService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	p.SetIfNotNil("orderId", s.orderID)
+	p.SetIfNotNil("origClientOrderId", s.origClientOrderID)
+	return p
+}
+
+func (s *QuerySpotOrderService) parseResponse(data []byte) (*QuerySpotOrderResponse, error) {
+	resp := &QuerySpotOrderResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s QuerySpotOrderService) WithSymbolREST(symbolREST string) *QuerySpotOrderService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+// WithOrderID returns a copy of the service with orderID set to the given value.
+func (s QuerySpotOrderService) WithOrderID(orderID int64) *QuerySpotOrderService {
+	orderIDStr := strconv.FormatInt(orderID, 10)
+	s.orderID = &orderIDStr
+	return &s
+}
+
+// WithOrigClientOrderID returns a copy of the service with origClientOrderID
+// set to the given value.
+func (s QuerySpotOrderService) WithOrigClientOrderID(origClientOrderID string) *QuerySpotOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return &s
+}
+
+/* ==================== OpenSpotOrdersService ============================ */
+
+// OpenSpotOrdersResponse is the response of an OpenSpotOrdersService.
+type OpenSpotOrdersResponse struct {
+	ServiceBaseResponse
+	Orders []SpotOrderDetails
+}
+
+// OpenSpotOrdersService gets all open spot orders on a symbol, or on all
+// symbols if no symbol is set.
+type OpenSpotOrdersService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns an OpenSpotOrdersResponse.
+func (s *OpenSpotOrdersService) Do(ctx context.Context) (*OpenSpotOrdersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *OpenSpotOrdersService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+func (s *OpenSpotOrdersService) parseResponse(data []byte) (*OpenSpotOrdersResponse, error) {
+	resp := &OpenSpotOrdersResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &resp.Orders); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 80
+// to 6.
+func (s OpenSpotOrdersService) WithSymbolREST(symbolREST string) *OpenSpotOrdersService {
+	s.symbolREST = &symbolREST
+	s.SM.SD.WeightIP = 6
+	return &s
+}
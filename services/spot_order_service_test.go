@@ -0,0 +1,191 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiCreateOrderFullData = []byte(`{
+  "symbol": "BTCUSDT",
+  "orderId": 28,
+  "orderListId": -1,
+  "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+  "transactTime": 1507725176595,
+  "price": "0.00000000",
+  "origQty": "10.00000000",
+  "executedQty": "10.00000000",
+  "origQuoteOrderQty": "0.000000",
+  "cummulativeQuoteQty": "10.00000000",
+  "status": "FILLED",
+  "timeInForce": "GTC",
+  "type": "MARKET",
+  "side": "SELL",
+  "workingTime": 1507725176595,
+  "selfTradePreventionMode": "NONE",
+  "fills": [
+    {"price": "4000.00000000", "qty": "1.00000000", "commission": "4.00000000", "commissionAsset": "USDT", "tradeId": 56}
+  ]
+}`)
+
+	apiCreateOrderFullTarget = &CreateSpotOrderResponse{SpotNewOrder: SpotNewOrder{
+		SpotOrder: SpotOrder{
+			Symbol:                  "BTCUSDT",
+			OrderID:                 28,
+			OrderListID:             -1,
+			ClientOrderID:           "6gCrw2kRUAF9CvJDGP16IP",
+			Price:                   "0.00000000",
+			OrigQty:                 "10.00000000",
+			ExecutedQty:             "10.00000000",
+			CumQuoteQty:             "10.00000000",
+			OrigQuoteOrderQty:       "0.000000",
+			Status:                  common.OrderStatusFilled,
+			TimeInForce:             common.OrderTimeInForceGTC,
+			OrderType:               common.OrderTypeMarket,
+			Side:                    common.OrderSideSell,
+			TSSWorking:              common.NewTSNano(1507725176595),
+			SelfTradePreventionMode: common.SelfTradePreventionModeNone,
+		},
+		TSSTransact: common.NewTSNano(1507725176595),
+		Fills: []SpotOrderFill{
+			{Price: "4000.00000000", Qty: "1.00000000", Commission: "4.00000000", CommissionAsset: "USDT", TradeID: 56},
+		},
+	}}
+
+	apiTestOrderCommissionData = []byte(`{
+  "standardCommissionForOrder": {"maker": "0.00000112", "taker": "0.00000114"},
+  "taxCommissionForOrder": {"maker": "0.00000112", "taker": "0.00000114"},
+  "discount": {"enabledForAccount": true, "enabledForSymbol": true, "discountAsset": "BNB", "discount": "0.25000000"}
+}`)
+
+	apiTestOrderCommissionTarget = &TestSpotOrderResponse{
+		StandardCommissionForOrder: &CommissionRates{Maker: "0.00000112", Taker: "0.00000114"},
+		TaxCommissionForOrder:      &CommissionRates{Maker: "0.00000112", Taker: "0.00000114"},
+		Discount:                   &CommissionDiscount{EnabledForAccount: true, EnabledForSymbol: true, DiscountAsset: "BNB", Discount: "0.25000000"},
+	}
+
+	apiCancelOpenOrdersData = []byte(`[
+  {
+    "symbol": "BTCUSDT",
+    "origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+    "orderId": 11,
+    "orderListId": -1,
+    "clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+    "transactTime": 1684804350068,
+    "price": "0.089853",
+    "origQty": "0.178622",
+    "executedQty": "0.000000",
+    "cummulativeQuoteQty": "0.000000",
+    "status": "CANCELED",
+    "timeInForce": "GTC",
+    "type": "LIMIT",
+    "side": "BUY",
+    "selfTradePreventionMode": "NONE"
+  },
+  {
+    "orderListId": 1929,
+    "contingencyType": "OCO",
+    "listStatusType": "ALL_DONE",
+    "listOrderStatus": "ALL_DONE",
+    "listClientOrderId": "2inzWQdDvZLHbbAmAozX2N",
+    "transactionTime": 1585230948299,
+    "symbol": "BTCUSDT",
+    "orders": [],
+    "orderReports": []
+  }
+]`)
+
+	apiOpenOrdersData = []byte(`[
+  {
+    "symbol": "LTCBTC",
+    "orderId": 1,
+    "orderListId": -1,
+    "clientOrderId": "myOrder1",
+    "price": "0.1",
+    "origQty": "1.0",
+    "executedQty": "0.0",
+    "cummulativeQuoteQty": "0.0",
+    "status": "NEW",
+    "timeInForce": "GTC",
+    "type": "LIMIT",
+    "side": "BUY",
+    "stopPrice": "0.0",
+    "icebergQty": "0.0",
+    "time": 1499827319559,
+    "updateTime": 1499827319559,
+    "isWorking": true,
+    "workingTime": 1499827319559,
+    "origQuoteOrderQty": "0.000000",
+    "selfTradePreventionMode": "NONE"
+  }
+]`)
+)
+
+func TestCreateSpotOrderService(t *testing.T) {
+	service := &CreateSpotOrderService{SM: *common.NewServiceMeta(APIServices["createOrder"])}
+
+	// newOrderRespType is only sent if set by the caller
+	p := service.WithMarketOrderParams("BTCUSDT", common.OrderSideSell, "10").toParams()
+	assert.Equal(t, params{"symbol": "BTCUSDT", "side": "SELL", "type": "MARKET", "quantity": "10"}, p)
+
+	p = service.WithNewOrderRespType(common.OrderResponseTypeAcknowledge).toParams()
+	assert.Equal(t, "ACK", p["newOrderRespType"])
+
+	resp, err := service.parseResponse(apiCreateOrderFullData)
+	assert.Nil(t, err)
+	assert.Equal(t, apiCreateOrderFullTarget, resp)
+}
+
+func TestTestSpotOrderService(t *testing.T) {
+	order := (&CreateSpotOrderService{}).WithLimitOrderParams("BTCUSDT", common.OrderSideBuy, "1", "100", common.OrderTimeInForceGTC)
+	service := &TestSpotOrderService{SM: *common.NewServiceMeta(APIServices["testOrder"])}
+	assert.Equal(t, 1, service.SM.SD.WeightIP)
+
+	service = service.WithOrder(order).WithComputeCommissionRates(true)
+	assert.Equal(t, 20, service.SM.SD.WeightIP)
+	assert.Equal(t, params{
+		"symbol": "BTCUSDT", "side": "BUY", "type": "LIMIT", "quantity": "1", "price": "100",
+		"timeInForce": "GTC", "computeCommissionRates": "true",
+	}, service.toParams())
+
+	resp, err := service.parseResponse(apiTestOrderCommissionData)
+	assert.Nil(t, err)
+	assert.Equal(t, apiTestOrderCommissionTarget, resp)
+
+	resp, err = service.parseResponse([]byte(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, &TestSpotOrderResponse{}, resp)
+}
+
+func TestCancelAllSpotOrdersService(t *testing.T) {
+	service := &CancelAllSpotOrdersService{SM: *common.NewServiceMeta(APIServices["cancelOpenOrders"])}
+
+	resp, err := service.parseResponse(apiCancelOpenOrdersData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Orders, 1)
+	assert.Len(t, resp.OrderLists, 1)
+	assert.Equal(t, int64(1929), resp.OrderLists[0].OrderListID)
+	assert.Equal(t, common.ContingencyTypeOCO, resp.OrderLists[0].ContingencyType)
+	assert.Equal(t, int64(11), resp.Orders[0].OrderID)
+	assert.Equal(t, "E6APeyTJvkMvLMYMqu1KQ4", resp.Orders[0].OrigClientOrderID)
+	assert.Equal(t, common.OrderStatusCanceled, resp.Orders[0].Status)
+}
+
+func TestOpenSpotOrdersService(t *testing.T) {
+	service := &OpenSpotOrdersService{SM: *common.NewServiceMeta(APIServices["openOrders"])}
+	assert.Equal(t, 80, service.SM.SD.WeightIP)
+	assert.Equal(t, params{}, service.toParams())
+
+	service = service.WithSymbolREST("LTCBTC")
+	assert.Equal(t, 6, service.SM.SD.WeightIP)
+	assert.Equal(t, params{"symbol": "LTCBTC"}, service.toParams())
+
+	resp, err := service.parseResponse(apiOpenOrdersData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Orders, 1)
+	assert.Equal(t, common.NewTSNano(1499827319559), resp.Orders[0].TSSCreated)
+	assert.True(t, resp.Orders[0].IsWorking)
+	assert.Equal(t, "myOrder1", resp.Orders[0].ClientOrderID)
+}
@@ -0,0 +1,646 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Shared Responses ================================= */
+
+// Ticker is the price change statistics of a symbol over a time window.
+// MINI tickers and futures tickers leave some fields empty.
+type Ticker struct {
+	Symbol             string        `json:"symbol"`
+	PriceChange        string        `json:"priceChange"`        // (FULL)
+	PriceChangePercent string        `json:"priceChangePercent"` // (FULL)
+	WeightedAvgPrice   string        `json:"weightedAvgPrice"`   // (FULL)
+	PrevClosePrice     string        `json:"prevClosePrice"`     // (SPOT & MARGIN 24hr FULL)
+	LastPrice          string        `json:"lastPrice"`
+	LastQty            string        `json:"lastQty"`  // (24hr FULL)
+	BidPrice           string        `json:"bidPrice"` // (SPOT & MARGIN 24hr FULL)
+	BidQty             string        `json:"bidQty"`   // (SPOT & MARGIN 24hr FULL)
+	AskPrice           string        `json:"askPrice"` // (SPOT & MARGIN 24hr FULL)
+	AskQty             string        `json:"askQty"`   // (SPOT & MARGIN 24hr FULL)
+	OpenPrice          string        `json:"openPrice"`
+	HighPrice          string        `json:"highPrice"`
+	LowPrice           string        `json:"lowPrice"`
+	Volume             string        `json:"volume"`
+	QuoteVolume        string        `json:"quoteVolume"`
+	TSSOpen            common.TSNano `json:"openTime"`
+	TSSClose           common.TSNano `json:"closeTime"`
+	FirstID            int64         `json:"firstId"`
+	LastID             int64         `json:"lastId"`
+	Count              int64         `json:"count"`
+}
+
+// TickersResponse is the response of all ticker services.
+type TickersResponse struct {
+	ServiceBaseResponse
+	Tickers []Ticker
+}
+
+func parseTickersResponse(sm *common.ServiceMeta, data []byte) (*TickersResponse, error) {
+	resp := &TickersResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalObjectOrArray(data, &resp.Tickers); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PriceTicker is the latest price of a symbol.
+type PriceTicker struct {
+	Symbol  string        `json:"symbol"`
+	Price   string        `json:"price"`
+	TSSTime common.TSNano `json:"time"` // (FUTURES)
+}
+
+// PriceTickersResponse is the response of a SpotMarginPriceTickerService or
+// a FuturesPriceTickerService.
+type PriceTickersResponse struct {
+	ServiceBaseResponse
+	PriceTickers []PriceTicker
+}
+
+func parsePriceTickersResponse(sm *common.ServiceMeta, data []byte) (*PriceTickersResponse, error) {
+	resp := &PriceTickersResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalObjectOrArray(data, &resp.PriceTickers); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// BookTicker is the best bid and ask of a symbol's order book.
+type BookTicker struct {
+	Symbol       string        `json:"symbol"`
+	BidPrice     string        `json:"bidPrice"`
+	BidQty       string        `json:"bidQty"`
+	AskPrice     string        `json:"askPrice"`
+	AskQty       string        `json:"askQty"`
+	LastUpdateID int64         `json:"lastUpdateId"` // (FUTURES)
+	TSSTime      common.TSNano `json:"time"`         // (FUTURES)
+}
+
+// BookTickersResponse is the response of a SpotMarginBookTickerService or a
+// FuturesBookTickerService.
+type BookTickersResponse struct {
+	ServiceBaseResponse
+	BookTickers []BookTicker
+}
+
+func parseBookTickersResponse(sm *common.ServiceMeta, data []byte) (*BookTickersResponse, error) {
+	resp := &BookTickersResponse{}
+
+	if err := resp.ParseBaseResponse(sm); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalObjectOrArray(data, &resp.BookTickers); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/* ==================== SpotMarginAvgPriceService ======================== */
+
+// SpotMarginAvgPriceResponse is the response of a SpotMarginAvgPriceService.
+type SpotMarginAvgPriceResponse struct {
+	ServiceBaseResponse
+	Mins     int           `json:"mins"` // average price interval in minutes
+	Price    string        `json:"price"`
+	TSSClose common.TSNano `json:"closeTime"` // last trade time
+}
+
+// SpotMarginAvgPriceService gets the current average price of a symbol.
+type SpotMarginAvgPriceService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST string
+}
+
+// Do sends the request and returns a SpotMarginAvgPriceResponse.
+func (s *SpotMarginAvgPriceService) Do(ctx context.Context) (*SpotMarginAvgPriceResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := s.parseResponse(data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotMarginAvgPriceService) toParams() params {
+	p := params{}
+	p.Set("symbol", s.symbolREST)
+	return p
+}
+
+func (s *SpotMarginAvgPriceService) parseResponse(data []byte) (*SpotMarginAvgPriceResponse, error) {
+	resp := &SpotMarginAvgPriceResponse{}
+
+	if err := resp.ParseBaseResponse(&s.SM); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotMarginAvgPriceService) WithSymbolREST(symbolREST string) *SpotMarginAvgPriceService {
+	s.symbolREST = symbolREST
+	return &s
+}
+
+/* ==================== SpotMarginTicker24hrService ====================== */
+
+// SpotMarginTicker24hrService gets the 24 hour rolling window price change
+// statistics of a symbol, of a list of symbols, or of all symbols.
+type SpotMarginTicker24hrService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	symbols    *[]string
+	tickerType *string // (default: FULL)
+}
+
+// Do sends the request and returns a TickersResponse.
+func (s *SpotMarginTicker24hrService) Do(ctx context.Context) (*TickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotMarginTicker24hrService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetSliceIfNotNil("symbols", s.symbols)
+	p.SetIfNotNil("type", s.tickerType)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 80
+// to 2.
+func (s SpotMarginTicker24hrService) WithSymbolREST(symbolREST string) *SpotMarginTicker24hrService {
+	s.symbolREST = &symbolREST
+	s.symbols = nil
+	s.SM.SD.WeightIP = 2
+	return &s
+}
+
+// WithSymbols returns a copy of the service with symbols set to the given
+// values. The request weight depends on the number of symbols.
+func (s SpotMarginTicker24hrService) WithSymbols(symbols ...string) *SpotMarginTicker24hrService {
+	s.symbols = &symbols
+	s.symbolREST = nil
+	s.SM.SD.WeightIP = spotMarginTicker24hrWeight(len(symbols))
+	return &s
+}
+
+// WithType returns a copy of the service with tickerType set to the given value.
+func (s SpotMarginTicker24hrService) WithType(tickerType common.BITickerType) *SpotMarginTicker24hrService {
+	tickerTypeStr := string(tickerType)
+	s.tickerType = &tickerTypeStr
+	return &s
+}
+
+// spotMarginTicker24hrWeight returns the request weight of a 24hr ticker
+// request for numSymbols symbols.
+func spotMarginTicker24hrWeight(numSymbols int) int {
+	switch {
+	case numSymbols <= 20:
+		return 2
+	case numSymbols <= 100:
+		return 40
+	default:
+		return 80
+	}
+}
+
+/* ==================== SpotMarginRollingWindowTickerService ============= */
+
+// SpotMarginRollingWindowTickerService gets the price change statistics of
+// a symbol or of a list of symbols (max 100) within a rolling window.
+// The window is at most 59 seconds wider than windowSize.
+type SpotMarginRollingWindowTickerService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	symbols    *[]string
+	windowSize *string // (default: 1d) e.g. 1m-59m, 1h-23h, 1d-7d
+	tickerType *string // (default: FULL)
+}
+
+// Do sends the request and returns a TickersResponse.
+func (s *SpotMarginRollingWindowTickerService) Do(ctx context.Context) (*TickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotMarginRollingWindowTickerService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetSliceIfNotNil("symbols", s.symbols)
+	p.SetIfNotNil("windowSize", s.windowSize)
+	p.SetIfNotNil("type", s.tickerType)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotMarginRollingWindowTickerService) WithSymbolREST(symbolREST string) *SpotMarginRollingWindowTickerService {
+	s.symbolREST = &symbolREST
+	s.symbols = nil
+	s.SM.SD.WeightIP = spotMarginWindowTickerWeight(1)
+	return &s
+}
+
+// WithSymbols returns a copy of the service with symbols set to the given
+// values. The request weight depends on the number of symbols.
+func (s SpotMarginRollingWindowTickerService) WithSymbols(symbols ...string) *SpotMarginRollingWindowTickerService {
+	s.symbols = &symbols
+	s.symbolREST = nil
+	s.SM.SD.WeightIP = spotMarginWindowTickerWeight(len(symbols))
+	return &s
+}
+
+// WithWindowSize returns a copy of the service with windowSize set to the given value.
+func (s SpotMarginRollingWindowTickerService) WithWindowSize(windowSize common.BITickerWindowSize) *SpotMarginRollingWindowTickerService {
+	windowSizeStr := string(windowSize)
+	s.windowSize = &windowSizeStr
+	return &s
+}
+
+// WithType returns a copy of the service with tickerType set to the given value.
+func (s SpotMarginRollingWindowTickerService) WithType(tickerType common.BITickerType) *SpotMarginRollingWindowTickerService {
+	tickerTypeStr := string(tickerType)
+	s.tickerType = &tickerTypeStr
+	return &s
+}
+
+// spotMarginWindowTickerWeight returns the request weight of a rolling
+// window or trading day ticker request for numSymbols symbols.
+func spotMarginWindowTickerWeight(numSymbols int) int {
+	return min(4*numSymbols, 200)
+}
+
+/* ==================== SpotMarginTradingDayTickerService ================ */
+
+// SpotMarginTradingDayTickerService gets the price change statistics of a
+// symbol or of a list of symbols (max 100) during the current trading day.
+type SpotMarginTradingDayTickerService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	symbols    *[]string
+	timeZone   *string // (default: 0 (UTC))
+	tickerType *string // (default: FULL)
+}
+
+// Do sends the request and returns a TickersResponse.
+func (s *SpotMarginTradingDayTickerService) Do(ctx context.Context) (*TickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotMarginTradingDayTickerService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetSliceIfNotNil("symbols", s.symbols)
+	p.SetIfNotNil("timeZone", s.timeZone)
+	p.SetIfNotNil("type", s.tickerType)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the given value.
+func (s SpotMarginTradingDayTickerService) WithSymbolREST(symbolREST string) *SpotMarginTradingDayTickerService {
+	s.symbolREST = &symbolREST
+	s.symbols = nil
+	s.SM.SD.WeightIP = spotMarginWindowTickerWeight(1)
+	return &s
+}
+
+// WithSymbols returns a copy of the service with symbols set to the given
+// values. The request weight depends on the number of symbols.
+func (s SpotMarginTradingDayTickerService) WithSymbols(symbols ...string) *SpotMarginTradingDayTickerService {
+	s.symbols = &symbols
+	s.symbolREST = nil
+	s.SM.SD.WeightIP = spotMarginWindowTickerWeight(len(symbols))
+	return &s
+}
+
+// WithTimeZone returns a copy of the service with timeZone set to the given
+// value (e.g. "-1:00", "05:45", "0").
+func (s SpotMarginTradingDayTickerService) WithTimeZone(timeZone string) *SpotMarginTradingDayTickerService {
+	s.timeZone = &timeZone
+	return &s
+}
+
+// WithType returns a copy of the service with tickerType set to the given value.
+func (s SpotMarginTradingDayTickerService) WithType(tickerType common.BITickerType) *SpotMarginTradingDayTickerService {
+	tickerTypeStr := string(tickerType)
+	s.tickerType = &tickerTypeStr
+	return &s
+}
+
+/* ==================== SpotMarginPriceTickerService ===================== */
+
+// SpotMarginPriceTickerService gets the latest price of a symbol, of a list
+// of symbols, or of all symbols.
+type SpotMarginPriceTickerService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	symbols    *[]string
+}
+
+// Do sends the request and returns a PriceTickersResponse.
+func (s *SpotMarginPriceTickerService) Do(ctx context.Context) (*PriceTickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parsePriceTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotMarginPriceTickerService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetSliceIfNotNil("symbols", s.symbols)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 4
+// to 2.
+func (s SpotMarginPriceTickerService) WithSymbolREST(symbolREST string) *SpotMarginPriceTickerService {
+	s.symbolREST = &symbolREST
+	s.symbols = nil
+	s.SM.SD.WeightIP = 2
+	return &s
+}
+
+// WithSymbols returns a copy of the service with symbols set to the given values.
+func (s SpotMarginPriceTickerService) WithSymbols(symbols ...string) *SpotMarginPriceTickerService {
+	s.symbols = &symbols
+	s.symbolREST = nil
+	s.SM.SD.WeightIP = 4
+	return &s
+}
+
+/* ==================== SpotMarginBookTickerService ====================== */
+
+// SpotMarginBookTickerService gets the best bid and ask of a symbol, of a
+// list of symbols, or of all symbols.
+type SpotMarginBookTickerService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+	symbols    *[]string
+}
+
+// Do sends the request and returns a BookTickersResponse.
+func (s *SpotMarginBookTickerService) Do(ctx context.Context) (*BookTickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseBookTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *SpotMarginBookTickerService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	p.SetSliceIfNotNil("symbols", s.symbols)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 4
+// to 2.
+func (s SpotMarginBookTickerService) WithSymbolREST(symbolREST string) *SpotMarginBookTickerService {
+	s.symbolREST = &symbolREST
+	s.symbols = nil
+	s.SM.SD.WeightIP = 2
+	return &s
+}
+
+// WithSymbols returns a copy of the service with symbols set to the given values.
+func (s SpotMarginBookTickerService) WithSymbols(symbols ...string) *SpotMarginBookTickerService {
+	s.symbols = &symbols
+	s.symbolREST = nil
+	s.SM.SD.WeightIP = 4
+	return &s
+}
+
+/* ==================== FuturesTicker24hrService ========================= */
+
+// FuturesTicker24hrService gets the 24 hour rolling window price change
+// statistics of a symbol, or of all symbols.
+type FuturesTicker24hrService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns a TickersResponse.
+func (s *FuturesTicker24hrService) Do(ctx context.Context) (*TickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesTicker24hrService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 40
+// to 1.
+func (s FuturesTicker24hrService) WithSymbolREST(symbolREST string) *FuturesTicker24hrService {
+	s.symbolREST = &symbolREST
+	s.SM.SD.WeightIP = 1
+	return &s
+}
+
+/* ==================== FuturesPriceTickerService ======================== */
+
+// FuturesPriceTickerService gets the latest price of a symbol, or of all
+// symbols.
+type FuturesPriceTickerService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns a PriceTickersResponse.
+func (s *FuturesPriceTickerService) Do(ctx context.Context) (*PriceTickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parsePriceTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesPriceTickerService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 2
+// to 1.
+func (s FuturesPriceTickerService) WithSymbolREST(symbolREST string) *FuturesPriceTickerService {
+	s.symbolREST = &symbolREST
+	s.SM.SD.WeightIP = 1
+	return &s
+}
+
+/* ==================== FuturesBookTickerService ========================= */
+
+// FuturesBookTickerService gets the best bid and ask of a symbol, or of all
+// symbols.
+type FuturesBookTickerService struct {
+	SM         common.ServiceMeta
+	rc         common.RESTClient
+	logger     *log.Entry
+	symbolREST *string
+}
+
+// Do sends the request and returns a BookTickersResponse.
+func (s *FuturesBookTickerService) Do(ctx context.Context) (*BookTickersResponse, error) {
+	params := s.toParams()
+	data, err := s.rc.Do(ctx, &s.SM, params.UrlValues())
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+
+	resp, err := parseBookTickersResponse(&s.SM, data)
+	if err != nil {
+		s.logger.WithError(err).Error("Do")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toParams converts all parameter fields of the service to a params struct.
+func (s *FuturesBookTickerService) toParams() params {
+	p := params{}
+	p.SetIfNotNil("symbol", s.symbolREST)
+	return p
+}
+
+// WithSymbolREST returns a copy of the service with symbolREST set to the
+// given value. Querying a single symbol reduces the request weight from 5
+// to 2.
+func (s FuturesBookTickerService) WithSymbolREST(symbolREST string) *FuturesBookTickerService {
+	s.symbolREST = &symbolREST
+	s.SM.SD.WeightIP = 2
+	return &s
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiTicker24hrData = []byte(`{
+  "symbol": "BNBBTC",
+  "priceChange": "-94.99999800",
+  "priceChangePercent": "-95.960",
+  "weightedAvgPrice": "0.29628482",
+  "prevClosePrice": "0.10002000",
+  "lastPrice": "4.00000200",
+  "lastQty": "200.00000000",
+  "bidPrice": "4.00000000",
+  "bidQty": "100.00000000",
+  "askPrice": "4.00000200",
+  "askQty": "100.00000000",
+  "openPrice": "99.00000000",
+  "highPrice": "100.00000000",
+  "lowPrice": "0.10000000",
+  "volume": "8913.30000000",
+  "quoteVolume": "15.30000000",
+  "openTime": 1499783499040,
+  "closeTime": 1499869899040,
+  "firstId": 28385,
+  "lastId": 28460,
+  "count": 76
+}`)
+
+	apiTickerMiniData = []byte(`[
+  {
+    "symbol": "BNBBTC",
+    "openPrice": "99.00000000",
+    "highPrice": "100.00000000",
+    "lowPrice": "0.10000000",
+    "lastPrice": "4.00000200",
+    "volume": "8913.30000000",
+    "quoteVolume": "15.30000000",
+    "openTime": 1499783499040,
+    "closeTime": 1499869899040,
+    "firstId": 28385,
+    "lastId": 28460,
+    "count": 76
+  },
+  {
+    "symbol": "BTCUSDT",
+    "openPrice": "33.00000000",
+    "highPrice": "34.00000000",
+    "lowPrice": "32.00000000",
+    "lastPrice": "33.00000200",
+    "volume": "13.30000000",
+    "quoteVolume": "439.30000000",
+    "openTime": 1499783499040,
+    "closeTime": 1499869899040,
+    "firstId": 100,
+    "lastId": 110,
+    "count": 11
+  }
+]`)
+
+	fapiBookTickerData = []byte(`{
+  "lastUpdateId": 1027024,
+  "symbol": "BTCUSDT",
+  "bidPrice": "4.00000000",
+  "bidQty": "431.00000000",
+  "askPrice": "4.00000200",
+  "askQty": "9.00000000",
+  "time": 1589437530011
+}`)
+)
+
+func TestSpotMarginTicker24hrService(t *testing.T) {
+	service := NewSpotMarginTicker24hrService(nil, log.NewEntry(log.New()))
+	assert.Equal(t, params{}, service.toParams())
+	assert.Equal(t, 80, service.SM.SD.WeightIP)
+
+	service = service.WithSymbolREST("BNBBTC").WithType(common.TickerTypeFull)
+	assert.Equal(t, params{"symbol": "BNBBTC", "type": "FULL"}, service.toParams())
+	assert.Equal(t, 2, service.SM.SD.WeightIP)
+
+	service = service.WithSymbols("BNBBTC", "BTCUSDT")
+	assert.Equal(t, params{"symbols": `["BNBBTC","BTCUSDT"]`, "type": "FULL"}, service.toParams())
+	assert.Equal(t, 2, service.SM.SD.WeightIP)
+	assert.Equal(t, 40, service.WithSymbols(make([]string, 21)...).SM.SD.WeightIP)
+	assert.Equal(t, 80, service.WithSymbols(make([]string, 101)...).SM.SD.WeightIP)
+
+	resp, err := parseTickersResponse(&service.SM, apiTicker24hrData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Tickers, 1)
+	assert.Equal(t, "0.10002000", resp.Tickers[0].PrevClosePrice)
+	assert.Equal(t, "4.00000200", resp.Tickers[0].AskPrice)
+	assert.Equal(t, common.NewTSNano(1499869899040), resp.Tickers[0].TSSClose)
+	assert.Equal(t, int64(76), resp.Tickers[0].Count)
+}
+
+func TestSpotMarginRollingWindowTickerService(t *testing.T) {
+	service := NewSpotMarginRollingWindowTickerService(nil, log.NewEntry(log.New())).
+		WithSymbols("BNBBTC", "BTCUSDT").
+		WithWindowSize(common.TickerWindowSize4h).
+		WithType(common.TickerTypeMini)
+	assert.Equal(t, params{"symbols": `["BNBBTC","BTCUSDT"]`, "windowSize": "4h", "type": "MINI"}, service.toParams())
+	assert.Equal(t, 8, service.SM.SD.WeightIP)
+	assert.Equal(t, 4, service.WithSymbolREST("BNBBTC").SM.SD.WeightIP)
+	assert.Equal(t, 200, service.WithSymbols(make([]string, 100)...).SM.SD.WeightIP)
+
+	resp, err := parseTickersResponse(&service.SM, apiTickerMiniData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Tickers, 2)
+	assert.Equal(t, "BTCUSDT", resp.Tickers[1].Symbol)
+	assert.Equal(t, "", resp.Tickers[1].PriceChange)
+	assert.Equal(t, int64(110), resp.Tickers[1].LastID)
+}
+
+func TestSpotMarginPriceTickerService(t *testing.T) {
+	service := NewSpotMarginPriceTickerService(nil, log.NewEntry(log.New()))
+	assert.Equal(t, 4, service.SM.SD.WeightIP)
+	assert.Equal(t, 2, service.WithSymbolREST("LTCBTC").SM.SD.WeightIP)
+	assert.Equal(t, 4, service.WithSymbolREST("LTCBTC").WithSymbols("LTCBTC").SM.SD.WeightIP)
+
+	resp, err := parsePriceTickersResponse(&service.SM, []byte(`[{"symbol": "LTCBTC", "price": "4.00000200"}]`))
+	assert.Nil(t, err)
+	assert.Len(t, resp.PriceTickers, 1)
+	assert.Equal(t, "4.00000200", resp.PriceTickers[0].Price)
+}
+
+func TestFuturesBookTickerService(t *testing.T) {
+	service := NewFuturesBookTickerService(nil, log.NewEntry(log.New()))
+	assert.Equal(t, 5, service.SM.SD.WeightIP)
+
+	service = service.WithSymbolREST("BTCUSDT")
+	assert.Equal(t, params{"symbol": "BTCUSDT"}, service.toParams())
+	assert.Equal(t, 2, service.SM.SD.WeightIP)
+
+	resp, err := parseBookTickersResponse(&service.SM, fapiBookTickerData)
+	assert.Nil(t, err)
+	assert.Len(t, resp.BookTickers, 1)
+	assert.Equal(t, int64(1027024), resp.BookTickers[0].LastUpdateID)
+	assert.Equal(t, "9.00000000", resp.BookTickers[0].AskQty)
+	assert.Equal(t, common.NewTSNano(1589437530011), resp.BookTickers[0].TSSTime)
+}
@@ -2,8 +2,10 @@ package services
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 
 	"github.com/svdro/shrimpy-binance/common"
 )
@@ -83,3 +85,77 @@ func boolToUpperStr(b bool) string {
 	}
 	return "FALSE"
 }
+
+// errorData returns the data field of a BadRequestError or
+// UnexpectedStatusCodeError, or nil if err has no data.
+func errorData(err error) json.RawMessage {
+	var badRequestErr *common.BadRequestError
+	if errors.As(err, &badRequestErr) && len(badRequestErr.Data) > 0 {
+		return badRequestErr.Data
+	}
+
+	var unexpectedStatusCodeErr *common.UnexpectedStatusCodeError
+	if errors.As(err, &unexpectedStatusCodeErr) && len(unexpectedStatusCodeErr.Data) > 0 {
+		return unexpectedStatusCodeErr.Data
+	}
+	return nil
+}
+
+// tsNanoToMilliStr converts a TSNano to a string of milliseconds, the time
+// unit binance expects for startTime and endTime parameters.
+func tsNanoToMilliStr(ts common.TSNano) string {
+	return strconv.FormatInt(ts.Int64()/1e6, 10)
+}
+
+// splitOrdersAndOrderLists unmarshals data (a list of canceled orders and
+// order lists, as returned by the cancel all open orders endpoints) into
+// orders of type O and OrderListReports.
+func splitOrdersAndOrderLists[O any](data []byte) ([]O, []OrderListReport, error) {
+	items := []json.RawMessage{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, nil, err
+	}
+
+	// order lists have a contingencyType, orders do not.
+	var orders []O
+	var orderLists []OrderListReport
+	for _, item := range items {
+		probe := struct {
+			ContingencyType *string `json:"contingencyType"`
+		}{}
+		if err := json.Unmarshal(item, &probe); err != nil {
+			return nil, nil, err
+		}
+
+		if probe.ContingencyType != nil {
+			orderList := OrderListReport{}
+			if err := json.Unmarshal(item, &orderList); err != nil {
+				return nil, nil, err
+			}
+			orderLists = append(orderLists, orderList)
+			continue
+		}
+
+		var order O
+		if err := json.Unmarshal(item, &order); err != nil {
+			return nil, nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, orderLists, nil
+}
+
+// unmarshalObjectOrArray unmarshals data into v. Endpoints that take an
+// optional symbol return a single object if the symbol is sent, and an array
+// otherwise.
+func unmarshalObjectOrArray[T any](data []byte, v *[]T) error {
+	if len(data) > 0 && data[0] == '{' {
+		var item T
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		*v = []T{item}
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -32,8 +32,34 @@ func (s *AggTradesStream[E]) SetSymbol(restSymbol string) *AggTradesStream[E] {
 // path returns the path for the agg trades stream. It is used as the path
 // function for the stream.
 func (s *AggTradesStream[E]) path() string {
+	return aggTradesPath(*s.WSSymbol)
+}
+
+// CallbackAggTradesStream is the callback based variant of AggTradesStream.
+type CallbackAggTradesStream[E Event] struct {
+	common.Stream
+	Handler  *CallbackMarketStreamHandler[E]
+	WSSymbol *string
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream, and sets the path function for the stream.
+func (s *CallbackAggTradesStream[E]) SetSymbol(restSymbol string) *CallbackAggTradesStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// path returns the path for the agg trades stream.
+func (s *CallbackAggTradesStream[E]) path() string {
+	return aggTradesPath(*s.WSSymbol)
+}
+
+// aggTradesPath returns the path for an agg trades stream.
+func aggTradesPath(wsSymbol string) string {
 	path := "/ws/%s@aggTrade"
-	return fmt.Sprintf(path, *s.WSSymbol)
+	return fmt.Sprintf(path, wsSymbol)
 }
 
 /* ==================== sharedAggTradesEvent ============================= */
@@ -70,6 +96,12 @@ func newSpotMarginAggTradesHandler(logger *log.Entry) *SpotMarginAggTradesHandle
 // SpotMarginAggTradesStream is a stream for spot/margin agg trades streams.
 type SpotMarginAggTradesStream = AggTradesStream[*SpotMarginAggTradesEvent]
 
+// SpotMarginAggTradesCallbackHandler is a callback handler for spot/margin agg trades streams.
+type SpotMarginAggTradesCallbackHandler = CallbackMarketStreamHandler[*SpotMarginAggTradesEvent]
+
+// SpotMarginAggTradesCallbackStream is a callback stream for spot/margin agg trades streams.
+type SpotMarginAggTradesCallbackStream = CallbackAggTradesStream[*SpotMarginAggTradesEvent]
+
 /* ==================== Futures ======================================= */
 
 // FuturesAggTradesEvent is an agg trades event for futures streams.
@@ -87,3 +119,9 @@ func newFuturesAggTradesHandler(logger *log.Entry) *FuturesAggTradesHandler {
 
 // FuturesAggTradesStream is a stream for futures agg trades streams.
 type FuturesAggTradesStream = AggTradesStream[*FuturesAggTradesEvent]
+
+// FuturesAggTradesCallbackHandler is a callback handler for futures agg trades streams.
+type FuturesAggTradesCallbackHandler = CallbackMarketStreamHandler[*FuturesAggTradesEvent]
+
+// FuturesAggTradesCallbackStream is a callback stream for futures agg trades streams.
+type FuturesAggTradesCallbackStream = CallbackAggTradesStream[*FuturesAggTradesEvent]
@@ -0,0 +1,95 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Shared BookTickerStream ========================== */
+
+// BookTickerStream is a shared Stream implementation for book ticker streams.
+// It includes a Stream, a handler for market streams, and a symbol for
+// WebSocket communication. If no symbol is set, the stream is the all market
+// book ticker stream (!bookTicker).
+type BookTickerStream[E Event] struct {
+	common.Stream
+	Handler  *MarketStreamHandler[E]
+	WSSymbol *string
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (in this case wsSymbol is the only param).
+func (s *BookTickerStream[E]) SetSymbol(restSymbol string) *BookTickerStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// path returns the path for the book ticker stream. It is used as the path
+// function for the stream.
+func (s *BookTickerStream[E]) path() string {
+	if s.WSSymbol == nil {
+		return "/ws/!bookTicker"
+	}
+	path := "/ws/%s@bookTicker"
+	return fmt.Sprintf(path, *s.WSSymbol)
+}
+
+/* ==================== sharedBookTickerEvent ============================ */
+
+// sharedBookTickerEvent is a shared event for book ticker streams.
+type sharedBookTickerEvent struct {
+	UpdateID int64  `json:"u"`
+	Symbol   string `json:"s"`
+	BidPrice string `json:"b"`
+	BidQty   string `json:"B"`
+	AskPrice string `json:"a"`
+	AskQty   string `json:"A"`
+}
+
+/* ==================== SpotMargin ======================================= */
+
+// SpotMarginBookTickerEvent is a book ticker event for spot/margin streams.
+// NOTE: spot book ticker events do not have an event type or event time.
+type SpotMarginBookTickerEvent struct {
+	StreamBaseEvent
+	sharedBookTickerEvent
+}
+
+// SpotMarginBookTickerHandler is a handler for spot/margin book ticker streams.
+type SpotMarginBookTickerHandler = MarketStreamHandler[*SpotMarginBookTickerEvent]
+
+// newSpotMarginBookTickerHandler creates a new SpotMarginBookTickerHandler.
+func newSpotMarginBookTickerHandler(logger *log.Entry) *SpotMarginBookTickerHandler {
+	return newMarketStreamHandler[*SpotMarginBookTickerEvent](logger)
+}
+
+// SpotMarginBookTickerStream is a stream for spot/margin book ticker streams.
+// NOTE: binance has removed the all market book ticker stream (!bookTicker)
+// for spot, so a symbol must always be set.
+type SpotMarginBookTickerStream = BookTickerStream[*SpotMarginBookTickerEvent]
+
+/* ==================== Futures ========================================== */
+
+// FuturesBookTickerEvent is a book ticker event for futures streams.
+type FuturesBookTickerEvent struct {
+	StreamBaseEvent
+	sharedBookTickerEvent
+	TSSTransact common.TSNano `json:"T"`
+}
+
+// FuturesBookTickerHandler is a handler for futures book ticker streams.
+type FuturesBookTickerHandler = MarketStreamHandler[*FuturesBookTickerEvent]
+
+// newFuturesBookTickerHandler creates a new FuturesBookTickerHandler.
+func newFuturesBookTickerHandler(logger *log.Entry) *FuturesBookTickerHandler {
+	return newMarketStreamHandler[*FuturesBookTickerEvent](logger)
+}
+
+// FuturesBookTickerStream is a stream for futures book ticker streams.
+type FuturesBookTickerStream = BookTickerStream[*FuturesBookTickerEvent]
@@ -0,0 +1,88 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiBookTickerMsg = []byte(`{
+  "u": 400900217,
+  "s": "BNBUSDT",
+  "b": "25.35190000",
+  "B": "31.21000000",
+  "a": "25.36520000",
+  "A": "40.66000000"
+}`)
+
+	apiBookTickerTarget = &SpotMarginBookTickerEvent{
+		sharedBookTickerEvent: sharedBookTickerEvent{
+			UpdateID: 400900217,
+			Symbol:   "BNBUSDT",
+			BidPrice: "25.35190000",
+			BidQty:   "31.21000000",
+			AskPrice: "25.36520000",
+			AskQty:   "40.66000000",
+		},
+	}
+
+	fapiBookTickerMsg = []byte(`{
+  "e": "bookTicker",
+  "u": 400900217,
+  "E": 1568014460893,
+  "T": 1568014460891,
+  "s": "BNBUSDT",
+  "b": "25.35190000",
+  "B": "31.21000000",
+  "a": "25.36520000",
+  "A": "40.66000000"
+}`)
+
+	fapiBookTickerTarget = &FuturesBookTickerEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "bookTicker", TSSEvent: common.NewTSNano(1568014460893)},
+		sharedBookTickerEvent: sharedBookTickerEvent{
+			UpdateID: 400900217,
+			Symbol:   "BNBUSDT",
+			BidPrice: "25.35190000",
+			BidQty:   "31.21000000",
+			AskPrice: "25.36520000",
+			AskQty:   "40.66000000",
+		},
+		TSSTransact: common.NewTSNano(1568014460891),
+	}
+)
+
+func TestUnmarshalBookTickerEvent(t *testing.T) {
+	apiEvent := &SpotMarginBookTickerEvent{}
+	err := json.Unmarshal(apiBookTickerMsg, apiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, apiBookTickerTarget, apiEvent)
+
+	fapiEvent := &FuturesBookTickerEvent{}
+	err = json.Unmarshal(fapiBookTickerMsg, fapiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, fapiBookTickerTarget, fapiEvent)
+}
+
+func TestBookTickerHandler(t *testing.T) {
+	handler := newSpotMarginBookTickerHandler(nil)
+	assert.NotNil(t, handler)
+
+	// spot book ticker events have no event type, this must not be an error
+	wshErr := handler.HandleRecv(apiBookTickerMsg, 0, 0)
+	assert.Nil(t, wshErr)
+	event := <-handler.EventChan
+	assert.Equal(t, apiBookTickerTarget, event)
+}
+
+func TestBookTickerPaths(t *testing.T) {
+	symbol := "bnbusdt"
+	s := &FuturesBookTickerStream{WSSymbol: &symbol}
+	assert.Equal(t, "/ws/bnbusdt@bookTicker", s.path())
+
+	s = &FuturesBookTickerStream{}
+	assert.Equal(t, "/ws/!bookTicker", s.path())
+}
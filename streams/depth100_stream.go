@@ -16,9 +16,11 @@ import (
 // update speed for WebSocket communication.
 type DiffDepthStream[E Event] struct {
 	common.Stream
-	Handler     *MarketStreamHandler[E]
-	WSSymbol    *string
-	UpdateSpeed *int
+	Handler      *MarketStreamHandler[E]
+	WSSymbol     *string
+	UpdateSpeed  *int
+	endpointType common.BIEndpointType
+	logger       *log.Entry
 }
 
 // SetSymbol sets the wsSymbol that is used in generating the path for the
@@ -31,12 +33,144 @@ func (s *DiffDepthStream[E]) SetSymbol(restSymbol string) *DiffDepthStream[E] {
 	return s
 }
 
+// SetUpdateSpeed sets the update speed (milliseconds). The update speed must
+// be supported by the stream's market (see depthUpdateSpeeds), otherwise it
+// is ignored and an error is logged. It is ignored while the stream is running.
+func (s *DiffDepthStream[E]) SetUpdateSpeed(updateSpeed int) *DiffDepthStream[E] {
+	setDepthUpdateSpeed(s.Stream, s.UpdateSpeed, s.endpointType, updateSpeed, s.logger)
+	return s
+}
+
 // path returns the path for the diff depth stream. It is used as the path
 // function for the stream.
 func (s *DiffDepthStream[E]) path() string {
-	path := "/ws/%s@depth@%dms"
-	log.Info("path: ", fmt.Sprintf(path, *s.WSSymbol, *s.UpdateSpeed))
-	return fmt.Sprintf(path, *s.WSSymbol, *s.UpdateSpeed)
+	return diffDepthPath(*s.WSSymbol, s.endpointType, *s.UpdateSpeed)
+}
+
+// CallbackDiffDepthStream is the callback based variant of DiffDepthStream.
+type CallbackDiffDepthStream[E Event] struct {
+	common.Stream
+	Handler      *CallbackMarketStreamHandler[E]
+	WSSymbol     *string
+	UpdateSpeed  *int
+	endpointType common.BIEndpointType
+	logger       *log.Entry
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream, and sets the path function for the stream.
+func (s *CallbackDiffDepthStream[E]) SetSymbol(restSymbol string) *CallbackDiffDepthStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// SetUpdateSpeed sets the update speed (milliseconds). The update speed must
+// be supported by the stream's market, otherwise it is ignored and an error
+// is logged. It is ignored while the stream is running.
+func (s *CallbackDiffDepthStream[E]) SetUpdateSpeed(updateSpeed int) *CallbackDiffDepthStream[E] {
+	setDepthUpdateSpeed(s.Stream, s.UpdateSpeed, s.endpointType, updateSpeed, s.logger)
+	return s
+}
+
+// path returns the path for the diff depth stream.
+func (s *CallbackDiffDepthStream[E]) path() string {
+	return diffDepthPath(*s.WSSymbol, s.endpointType, *s.UpdateSpeed)
+}
+
+// diffDepthPath returns the path for a diff depth stream.
+// e.g. "/ws/btcusdt@depth" (default speed) or "/ws/btcusdt@depth@100ms"
+func diffDepthPath(wsSymbol string, endpointType common.BIEndpointType, updateSpeed int) string {
+	path := "/ws/%s@depth%s"
+	return fmt.Sprintf(path, wsSymbol, depthUpdateSpeedSuffix(endpointType, updateSpeed))
+}
+
+// newDiffDepthStream creates a new DiffDepthStream. The update speed of the
+// stream definition is the initial update speed of the stream.
+func newDiffDepthStream[E Event](
+	wc common.WSClient, sd common.StreamDefinition, caller string, logger *log.Entry,
+) *DiffDepthStream[E] {
+	sm := common.NewStreamMeta(sd)
+	handler := newMarketStreamHandler[E](logger.WithField("_caller", caller+"Handler"))
+	streamLogger := logger.WithField("_caller", caller+"Stream")
+
+	return &DiffDepthStream[E]{
+		Handler:      handler,
+		Stream:       wc.NewStream(sm, handler, streamLogger),
+		UpdateSpeed:  &sm.SD.UpdateSpeed,
+		endpointType: sm.SD.EndpointType,
+		logger:       streamLogger,
+	}
+}
+
+// newCallbackDiffDepthStream creates a new CallbackDiffDepthStream.
+func newCallbackDiffDepthStream[E Event](
+	wc common.WSClient, sd common.StreamDefinition, caller string, logger *log.Entry,
+) *CallbackDiffDepthStream[E] {
+	sm := common.NewStreamMeta(sd)
+	handler := newCallbackMarketStreamHandler[E](logger.WithField("_caller", caller+"Handler"))
+	streamLogger := logger.WithField("_caller", caller+"Stream")
+
+	return &CallbackDiffDepthStream[E]{
+		Handler:      handler,
+		Stream:       wc.NewStream(sm, handler, streamLogger),
+		UpdateSpeed:  &sm.SD.UpdateSpeed,
+		endpointType: sm.SD.EndpointType,
+		logger:       streamLogger,
+	}
+}
+
+/* ==================== depth update speeds ============================== */
+
+// depthUpdateSpeeds are the update speeds (milliseconds) that diff and
+// partial depth streams support per endpoint type. The first speed is the
+// default, it is used when the stream path has no speed suffix.
+var depthUpdateSpeeds = map[common.BIEndpointType][]int{
+	common.EndpointTypeAPI:  {1000, 100},
+	common.EndpointTypeFAPI: {250, 500, 100},
+}
+
+// validateDepthUpdateSpeed returns an error if the update speed is not
+// supported by depth streams of the endpoint type.
+func validateDepthUpdateSpeed(endpointType common.BIEndpointType, updateSpeed int) error {
+	speeds, ok := depthUpdateSpeeds[endpointType]
+	if !ok {
+		return fmt.Errorf("depth streams are not supported for endpoint type %s", endpointType)
+	}
+	if !containsInt(speeds, updateSpeed) {
+		return fmt.Errorf("update speed %dms is not supported for endpoint type %s (allowed: %v)", updateSpeed, endpointType, speeds)
+	}
+	return nil
+}
+
+// setDepthUpdateSpeed validates the update speed and sets it on target.
+// If the update speed is invalid, target is not changed and an error is logged.
+// Like SetPathFunc, it can't be called while the stream is running, because
+// the stream reads the update speed when it (re)connects.
+// NOTE: target points to the StreamDefinition's UpdateSpeed, so the stale
+// stream watchdog also picks up the new update speed.
+func setDepthUpdateSpeed(
+	stream common.Stream, target *int, endpointType common.BIEndpointType, updateSpeed int, logger *log.Entry,
+) {
+	if stream.Status().IsRunning {
+		logger.Warn("cannot set update speed while stream is running")
+		return
+	}
+	if err := validateDepthUpdateSpeed(endpointType, updateSpeed); err != nil {
+		logger.WithError(err).Error("SetUpdateSpeed: invalid update speed")
+		return
+	}
+	*target = updateSpeed
+}
+
+// depthUpdateSpeedSuffix returns the speed suffix of a depth stream path
+// (e.g. "@100ms"). It is empty for the default speed of the endpoint type.
+func depthUpdateSpeedSuffix(endpointType common.BIEndpointType, updateSpeed int) string {
+	if speeds, ok := depthUpdateSpeeds[endpointType]; ok && speeds[0] == updateSpeed {
+		return ""
+	}
+	return fmt.Sprintf("@%dms", updateSpeed)
 }
 
 /* ==================== sharedDiffDepthEvent ============================= */
@@ -87,6 +221,12 @@ func newSpotMarginDiffDepthHandler(logger *log.Entry) *SpotMarginDiffDepthHandle
 // SpotMarginDiffDepthStream is a diff depth stream for spot and margin markets.
 type SpotMarginDiffDepthStream = DiffDepthStream[*SpotMarginDiffDepthEvent]
 
+// SpotMarginDiffDepthCallbackHandler is a callback handler for spot and margin diff depth streams.
+type SpotMarginDiffDepthCallbackHandler = CallbackMarketStreamHandler[*SpotMarginDiffDepthEvent]
+
+// SpotMarginDiffDepthCallbackStream is a callback diff depth stream for spot and margin markets.
+type SpotMarginDiffDepthCallbackStream = CallbackDiffDepthStream[*SpotMarginDiffDepthEvent]
+
 /* ==================== Futures ========================================== */
 
 // FuturesDiffDepthEvent is a diff depth event for futures markets.
@@ -107,3 +247,9 @@ func newFuturesDiffDepthHandler(logger *log.Entry) *FuturesDiffDepthHandler {
 
 // FuturesDiffDepthStream is a diff depth stream for futures markets.
 type FuturesDiffDepthStream = DiffDepthStream[*FuturesDiffDepthEvent]
+
+// FuturesDiffDepthCallbackHandler is a callback handler for futures diff depth streams.
+type FuturesDiffDepthCallbackHandler = CallbackMarketStreamHandler[*FuturesDiffDepthEvent]
+
+// FuturesDiffDepthCallbackStream is a callback diff depth stream for futures markets.
+type FuturesDiffDepthCallbackStream = CallbackDiffDepthStream[*FuturesDiffDepthEvent]
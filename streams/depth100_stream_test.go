@@ -1,9 +1,11 @@
 package streams
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/svdro/shrimpy-binance/common"
 )
@@ -77,3 +79,71 @@ func TestDepthHandler(t *testing.T) {
 	assert.IsType(t, &FuturesDiffDepthEvent{}, event)
 	assert.Equal(t, fapiDepthTarget, event)
 }
+
+func TestDepthCallbackHandler(t *testing.T) {
+	handler := newCallbackMarketStreamHandler[*FuturesDiffDepthEvent](nil)
+	assert.NotNil(t, handler)
+
+	var event *FuturesDiffDepthEvent
+	handler.OnEvent(func(e *FuturesDiffDepthEvent) { event = e })
+
+	err := handler.HandleRecv(fapiDepthMsg, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, fapiDepthTarget, event)
+
+	var handledErr error
+	handler.OnError(func(err error) { handledErr = err })
+	handler.HandleError(&common.WSConnError{Reason: "test"})
+	assert.IsType(t, &common.WSConnError{}, handledErr)
+}
+
+// mockStream is a mock implementation of common.Stream that only reports
+// whether it is running.
+type mockStream struct {
+	isRunning bool
+}
+
+func (s *mockStream) Run(ctx context.Context)                             {}
+func (s *mockStream) SetPathFunc(f func() string)                         {}
+func (s *mockStream) WaitForConnection() <-chan bool                      { return nil }
+func (s *mockStream) LifecycleEvents() <-chan common.StreamLifecycleEvent { return nil }
+func (s *mockStream) Status() common.StreamStatus                         { return common.StreamStatus{IsRunning: s.isRunning} }
+func (s *mockStream) Reconnect()                                          {}
+
+func TestDiffDepthSetUpdateSpeed(t *testing.T) {
+	symbol, updateSpeed := "btcusdt", 1000
+	stream := &mockStream{}
+	s := &SpotMarginDiffDepthStream{
+		Stream:       stream,
+		WSSymbol:     &symbol,
+		UpdateSpeed:  &updateSpeed,
+		endpointType: common.EndpointTypeAPI,
+		logger:       log.NewEntry(log.StandardLogger()),
+	}
+	assert.Equal(t, "/ws/btcusdt@depth", s.path())
+
+	// 250ms is only supported by futures, the update speed is not changed
+	s.SetUpdateSpeed(250)
+	assert.Equal(t, 1000, updateSpeed)
+
+	s.SetUpdateSpeed(100)
+	assert.Equal(t, 100, updateSpeed)
+	assert.Equal(t, "/ws/btcusdt@depth@100ms", s.path())
+
+	// the update speed can't be changed while the stream is running
+	stream.isRunning = true
+	s.SetUpdateSpeed(1000)
+	assert.Equal(t, 100, updateSpeed)
+
+	fapiUpdateSpeed := 250
+	fs := &FuturesDiffDepthCallbackStream{
+		Stream:       &mockStream{},
+		WSSymbol:     &symbol,
+		UpdateSpeed:  &fapiUpdateSpeed,
+		endpointType: common.EndpointTypeFAPI,
+		logger:       log.NewEntry(log.StandardLogger()),
+	}
+	assert.Equal(t, "/ws/btcusdt@depth", fs.path())
+	fs.SetUpdateSpeed(500)
+	assert.Equal(t, "/ws/btcusdt@depth@500ms", fs.path())
+}
@@ -0,0 +1,264 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== MarkPrice ======================================== */
+
+// markPriceUpdateSpeeds are the update speeds (milliseconds) of mark price
+// streams. The first speed is the default.
+var markPriceUpdateSpeeds = []int{3000, 1000}
+
+// markPriceUpdateSpeedSuffix returns the speed suffix of a mark price stream
+// path. It is empty for the default speed (3s).
+func markPriceUpdateSpeedSuffix(updateSpeed int) string {
+	if updateSpeed == markPriceUpdateSpeeds[0] {
+		return ""
+	}
+	return fmt.Sprintf("@%ds", updateSpeed/1000)
+}
+
+// setMarkPriceUpdateSpeed validates the update speed and sets it on target.
+// If the update speed is invalid, target is not changed and an error is logged.
+// Like setDepthUpdateSpeed, it can't be called while the stream is running.
+func setMarkPriceUpdateSpeed(stream common.Stream, target *int, updateSpeed int, logger *log.Entry) {
+	if stream.Status().IsRunning {
+		logger.Warn("cannot set update speed while stream is running")
+		return
+	}
+	if !containsInt(markPriceUpdateSpeeds, updateSpeed) {
+		logger.WithField("updateSpeed", updateSpeed).Errorf(
+			"SetUpdateSpeed: update speed must be one of %v", markPriceUpdateSpeeds)
+		return
+	}
+	*target = updateSpeed
+}
+
+// FuturesMarkPriceEvent is a mark price and funding rate event.
+type FuturesMarkPriceEvent struct {
+	StreamBaseEvent
+	Symbol               string        `json:"s"`
+	MarkPrice            string        `json:"p"`
+	IndexPrice           string        `json:"i"`
+	EstimatedSettlePrice string        `json:"P"` // only useful in the last hour before settlement
+	FundingRate          string        `json:"r"`
+	TSSNextFunding       common.TSNano `json:"T"`
+}
+
+// FuturesMarkPriceHandler is a handler for futures mark price streams.
+type FuturesMarkPriceHandler = MarketStreamHandler[*FuturesMarkPriceEvent]
+
+// FuturesMarkPriceStream is a stream for futures mark price streams
+// (<symbol>@markPrice or <symbol>@markPrice@1s).
+type FuturesMarkPriceStream struct {
+	common.Stream
+	Handler     *FuturesMarkPriceHandler
+	WSSymbol    *string
+	UpdateSpeed *int
+	logger      *log.Entry
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (in this case wsSymbol is the only param).
+func (s *FuturesMarkPriceStream) SetSymbol(restSymbol string) *FuturesMarkPriceStream {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// SetUpdateSpeed sets the update speed (3000 or 1000 milliseconds). Any
+// other update speed is ignored and an error is logged. It is ignored while
+// the stream is running.
+func (s *FuturesMarkPriceStream) SetUpdateSpeed(updateSpeed int) *FuturesMarkPriceStream {
+	setMarkPriceUpdateSpeed(s.Stream, s.UpdateSpeed, updateSpeed, s.logger)
+	return s
+}
+
+// path returns the path for the mark price stream. It is used as the path
+// function for the stream.
+func (s *FuturesMarkPriceStream) path() string {
+	path := "/ws/%s@markPrice%s"
+	return fmt.Sprintf(path, *s.WSSymbol, markPriceUpdateSpeedSuffix(*s.UpdateSpeed))
+}
+
+// FuturesAllMarkPriceHandler is a handler for the futures all market mark
+// price stream.
+type FuturesAllMarkPriceHandler = MarketArrayStreamHandler[*FuturesMarkPriceEvent]
+
+// FuturesAllMarkPriceStream is a stream for the futures all market mark
+// price stream (!markPrice@arr or !markPrice@arr@1s). It has no path params.
+type FuturesAllMarkPriceStream struct {
+	common.Stream
+	Handler     *FuturesAllMarkPriceHandler
+	UpdateSpeed *int
+	logger      *log.Entry
+}
+
+// SetUpdateSpeed sets the update speed (3000 or 1000 milliseconds). Any
+// other update speed is ignored and an error is logged. It is ignored while
+// the stream is running.
+func (s *FuturesAllMarkPriceStream) SetUpdateSpeed(updateSpeed int) *FuturesAllMarkPriceStream {
+	setMarkPriceUpdateSpeed(s.Stream, s.UpdateSpeed, updateSpeed, s.logger)
+	return s
+}
+
+// path returns the path for the all market mark price stream. It is used as
+// the path function for the stream.
+func (s *FuturesAllMarkPriceStream) path() string {
+	path := "/ws/!markPrice@arr%s"
+	return fmt.Sprintf(path, markPriceUpdateSpeedSuffix(*s.UpdateSpeed))
+}
+
+/* ==================== ForceOrder ======================================= */
+
+// FuturesForceOrder is the liquidation order of a force order event.
+type FuturesForceOrder struct {
+	Symbol        string                    `json:"s"`
+	Side          common.BIOrderSide        `json:"S"`
+	Type          common.BIOrderType        `json:"o"`
+	TimeInForce   common.BIOrderTimeInForce `json:"f"`
+	OrigQty       string                    `json:"q"`
+	Price         string                    `json:"p"`
+	AvgPrice      string                    `json:"ap"`
+	Status        common.BIOrderStatus      `json:"X"`
+	LastFilledQty string                    `json:"l"`
+	CumFilledQty  string                    `json:"z"`
+	TSSTrade      common.TSNano             `json:"T"`
+}
+
+// FuturesForceOrderEvent is a liquidation order event.
+type FuturesForceOrderEvent struct {
+	StreamBaseEvent
+	Order FuturesForceOrder `json:"o"`
+}
+
+// FuturesForceOrderHandler is a handler for futures force order streams.
+type FuturesForceOrderHandler = MarketStreamHandler[*FuturesForceOrderEvent]
+
+// FuturesForceOrderStream is a stream for futures liquidation order streams.
+// If no symbol is set, the stream is the all market liquidation order stream
+// (!forceOrder@arr).
+// NOTE: despite its name, the all market stream sends one event per message,
+// not an array.
+type FuturesForceOrderStream struct {
+	common.Stream
+	Handler  *FuturesForceOrderHandler
+	WSSymbol *string
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (in this case wsSymbol is the only param).
+func (s *FuturesForceOrderStream) SetSymbol(restSymbol string) *FuturesForceOrderStream {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// path returns the path for the force order stream. It is used as the path
+// function for the stream.
+func (s *FuturesForceOrderStream) path() string {
+	if s.WSSymbol == nil {
+		return "/ws/!forceOrder@arr"
+	}
+	path := "/ws/%s@forceOrder"
+	return fmt.Sprintf(path, *s.WSSymbol)
+}
+
+/* ==================== CompositeIndex =================================== */
+
+// FuturesCompositeIndexComponent is a component of a composite index.
+type FuturesCompositeIndexComponent struct {
+	BaseAsset          string `json:"b"`
+	QuoteAsset         string `json:"q"`
+	WeightInQuantity   string `json:"w"`
+	WeightInPercentage string `json:"W"`
+	IndexPrice         string `json:"i"`
+}
+
+// FuturesCompositeIndexEvent is a composite index event.
+type FuturesCompositeIndexEvent struct {
+	StreamBaseEvent
+	Symbol     string                           `json:"s"`
+	Price      string                           `json:"p"`
+	BaseAsset  string                           `json:"C"`
+	Components []FuturesCompositeIndexComponent `json:"c"`
+}
+
+// FuturesCompositeIndexHandler is a handler for futures composite index streams.
+type FuturesCompositeIndexHandler = MarketStreamHandler[*FuturesCompositeIndexEvent]
+
+// FuturesCompositeIndexStream is a stream for futures composite index
+// streams (<symbol>@compositeIndex).
+type FuturesCompositeIndexStream struct {
+	common.Stream
+	Handler  *FuturesCompositeIndexHandler
+	WSSymbol *string
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (in this case wsSymbol is the only param).
+func (s *FuturesCompositeIndexStream) SetSymbol(restSymbol string) *FuturesCompositeIndexStream {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// path returns the path for the composite index stream. It is used as the
+// path function for the stream.
+func (s *FuturesCompositeIndexStream) path() string {
+	path := "/ws/%s@compositeIndex"
+	return fmt.Sprintf(path, *s.WSSymbol)
+}
+
+/* ==================== ContractInfo ===================================== */
+
+// FuturesContractInfoBracket is a notional bracket of a contract info event.
+type FuturesContractInfoBracket struct {
+	Bracket                int     `json:"bs"`
+	NotionalFloor          float64 `json:"bnf"`
+	NotionalCap            float64 `json:"bnc"`
+	MaintenanceMarginRatio float64 `json:"mmr"`
+	Cum                    float64 `json:"cf"`
+	MinLeverage            int     `json:"mi"`
+	MaxLeverage            int     `json:"ma"`
+}
+
+// FuturesContractInfoEvent is a contract info event. It is sent when a
+// contract is listed, settled or when its brackets change.
+type FuturesContractInfoEvent struct {
+	StreamBaseEvent
+	Symbol         string                       `json:"s"`
+	Pair           string                       `json:"ps"`
+	ContractType   common.BIContractType        `json:"ct"`
+	TSSDelivery    common.TSNano                `json:"dt"`
+	TSSOnboard     common.TSNano                `json:"ot"`
+	ContractStatus common.BIContractStatus      `json:"cs"`
+	Brackets       []FuturesContractInfoBracket `json:"bks"`
+}
+
+// FuturesContractInfoHandler is a handler for the futures contract info stream.
+type FuturesContractInfoHandler = MarketStreamHandler[*FuturesContractInfoEvent]
+
+// FuturesContractInfoStream is a stream for the futures contract info stream
+// (!contractInfo). It has no path params.
+type FuturesContractInfoStream struct {
+	common.Stream
+	Handler *FuturesContractInfoHandler
+}
+
+// path returns the path for the contract info stream. It is used as the path
+// function for the stream.
+func (s *FuturesContractInfoStream) path() string {
+	return "/ws/!contractInfo"
+}
@@ -0,0 +1,173 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	fapiMarkPriceMsg = []byte(`{
+  "e": "markPriceUpdate",
+  "E": 1562305380000,
+  "s": "BTCUSDT",
+  "p": "11794.15000000",
+  "i": "11784.62659091",
+  "P": "11784.25641265",
+  "r": "0.00038167",
+  "T": 1562306400000
+}`)
+
+	fapiMarkPriceTarget = &FuturesMarkPriceEvent{
+		StreamBaseEvent:      StreamBaseEvent{EventType: "markPriceUpdate", TSSEvent: common.NewTSNano(1562305380000)},
+		Symbol:               "BTCUSDT",
+		MarkPrice:            "11794.15000000",
+		IndexPrice:           "11784.62659091",
+		EstimatedSettlePrice: "11784.25641265",
+		FundingRate:          "0.00038167",
+		TSSNextFunding:       common.NewTSNano(1562306400000),
+	}
+
+	fapiForceOrderMsg = []byte(`{
+  "e": "forceOrder",
+  "E": 1568014460893,
+  "o": {
+    "s": "BTCUSDT",
+    "S": "SELL",
+    "o": "LIMIT",
+    "f": "IOC",
+    "q": "0.014",
+    "p": "9910",
+    "ap": "9910",
+    "X": "FILLED",
+    "l": "0.014",
+    "z": "0.014",
+    "T": 1568014460893
+  }
+}`)
+
+	fapiForceOrderTarget = &FuturesForceOrderEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "forceOrder", TSSEvent: common.NewTSNano(1568014460893)},
+		Order: FuturesForceOrder{
+			Symbol:        "BTCUSDT",
+			Side:          common.OrderSideSell,
+			Type:          common.OrderTypeLimit,
+			TimeInForce:   common.OrderTimeInForceIOC,
+			OrigQty:       "0.014",
+			Price:         "9910",
+			AvgPrice:      "9910",
+			Status:        common.OrderStatusFilled,
+			LastFilledQty: "0.014",
+			CumFilledQty:  "0.014",
+			TSSTrade:      common.NewTSNano(1568014460893),
+		},
+	}
+
+	fapiCompositeIndexMsg = []byte(`{
+  "e": "compositeIndex",
+  "E": 1602310596000,
+  "s": "DEFIUSDT",
+  "p": "554.41604065",
+  "C": "baseAsset",
+  "c": [
+    {"b": "BAL", "q": "USDT", "w": "1.04884844", "W": "0.01457800", "i": "24.33521021"}
+  ]
+}`)
+
+	fapiCompositeIndexTarget = &FuturesCompositeIndexEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "compositeIndex", TSSEvent: common.NewTSNano(1602310596000)},
+		Symbol:          "DEFIUSDT",
+		Price:           "554.41604065",
+		BaseAsset:       "baseAsset",
+		Components: []FuturesCompositeIndexComponent{
+			{BaseAsset: "BAL", QuoteAsset: "USDT", WeightInQuantity: "1.04884844", WeightInPercentage: "0.01457800", IndexPrice: "24.33521021"},
+		},
+	}
+
+	fapiContractInfoMsg = []byte(`{
+  "e": "contractInfo",
+  "E": 1669356423908,
+  "s": "IOTAUSDT",
+  "ps": "IOTAUSDT",
+  "ct": "PERPETUAL",
+  "dt": 4133404800000,
+  "ot": 1569398400000,
+  "cs": "TRADING",
+  "bks": [
+    {"bs": 1, "bnf": 0, "bnc": 5000, "mmr": 0.01, "cf": 0, "mi": 21, "ma": 50}
+  ]
+}`)
+
+	fapiContractInfoTarget = &FuturesContractInfoEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "contractInfo", TSSEvent: common.NewTSNano(1669356423908)},
+		Symbol:          "IOTAUSDT",
+		Pair:            "IOTAUSDT",
+		ContractType:    common.ContractTypePerpetual,
+		TSSDelivery:     common.NewTSNano(4133404800000),
+		TSSOnboard:      common.NewTSNano(1569398400000),
+		ContractStatus:  common.ContractStatusTrading,
+		Brackets: []FuturesContractInfoBracket{
+			{Bracket: 1, NotionalFloor: 0, NotionalCap: 5000, MaintenanceMarginRatio: 0.01, Cum: 0, MinLeverage: 21, MaxLeverage: 50},
+		},
+	}
+)
+
+func TestUnmarshalFuturesMarketEvents(t *testing.T) {
+	markPriceEvent := &FuturesMarkPriceEvent{}
+	assert.Nil(t, json.Unmarshal(fapiMarkPriceMsg, markPriceEvent))
+	assert.Equal(t, fapiMarkPriceTarget, markPriceEvent)
+
+	forceOrderEvent := &FuturesForceOrderEvent{}
+	assert.Nil(t, json.Unmarshal(fapiForceOrderMsg, forceOrderEvent))
+	assert.Equal(t, fapiForceOrderTarget, forceOrderEvent)
+
+	compositeIndexEvent := &FuturesCompositeIndexEvent{}
+	assert.Nil(t, json.Unmarshal(fapiCompositeIndexMsg, compositeIndexEvent))
+	assert.Equal(t, fapiCompositeIndexTarget, compositeIndexEvent)
+
+	contractInfoEvent := &FuturesContractInfoEvent{}
+	assert.Nil(t, json.Unmarshal(fapiContractInfoMsg, contractInfoEvent))
+	assert.Equal(t, fapiContractInfoTarget, contractInfoEvent)
+}
+
+func TestFuturesMarketStreamPaths(t *testing.T) {
+	symbol := "btcusdt"
+	updateSpeed := 3000
+	logger := log.NewEntry(log.New())
+
+	ms := &FuturesMarkPriceStream{Stream: &mockStream{}, WSSymbol: &symbol, UpdateSpeed: &updateSpeed, logger: logger}
+	assert.Equal(t, "/ws/btcusdt@markPrice", ms.path())
+	ms.SetUpdateSpeed(1000)
+	assert.Equal(t, "/ws/btcusdt@markPrice@1s", ms.path())
+
+	// invalid update speeds are ignored
+	ms.SetUpdateSpeed(500)
+	assert.Equal(t, 1000, *ms.UpdateSpeed)
+
+	allUpdateSpeed := 3000
+	amsStream := &mockStream{}
+	ams := &FuturesAllMarkPriceStream{Stream: amsStream, UpdateSpeed: &allUpdateSpeed, logger: logger}
+	assert.Equal(t, "/ws/!markPrice@arr", ams.path())
+
+	// the update speed can't be changed while the stream is running
+	amsStream.isRunning = true
+	ams.SetUpdateSpeed(1000)
+	assert.Equal(t, "/ws/!markPrice@arr", ams.path())
+	amsStream.isRunning = false
+	ams.SetUpdateSpeed(1000)
+	assert.Equal(t, "/ws/!markPrice@arr@1s", ams.path())
+
+	fs := &FuturesForceOrderStream{}
+	assert.Equal(t, "/ws/!forceOrder@arr", fs.path())
+	fs.WSSymbol = &symbol
+	assert.Equal(t, "/ws/btcusdt@forceOrder", fs.path())
+
+	defiSymbol := "defiusdt"
+	cs := &FuturesCompositeIndexStream{WSSymbol: &defiSymbol}
+	assert.Equal(t, "/ws/defiusdt@compositeIndex", cs.path())
+
+	assert.Equal(t, "/ws/!contractInfo", (&FuturesContractInfoStream{}).path())
+}
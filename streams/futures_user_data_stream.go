@@ -0,0 +1,181 @@
+package streams
+
+import (
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== FuturesUserDataStream ============================ */
+
+// FuturesUserDataStream is a user data stream for futures markets.
+type FuturesUserDataStream struct {
+	common.Stream
+	Handler   *FuturesUserDataStreamHandler
+	ListenKey *string
+}
+
+// SetListenKey sets the listen key that is used in generating the path for
+// the stream, and sets the path function for the stream.
+func (s *FuturesUserDataStream) SetListenKey(listenKey string) *FuturesUserDataStream {
+	s.ListenKey = &listenKey
+	s.SetPathFunc(s.path)
+	return s
+}
+
+func (s *FuturesUserDataStream) path() string {
+	return UserDataPath(*s.ListenKey)
+}
+
+/* ==================== FuturesUserDataEvents ============================ */
+
+// FuturesBalance is a balance of a futures account update event.
+type FuturesBalance struct {
+	Asset              string `json:"a"`
+	WalletBalance      string `json:"wb"`
+	CrossWalletBalance string `json:"cw"`
+	BalanceChange      string `json:"bc"` // balance change except PnL and commission
+}
+
+// FuturesPosition is a position of a futures account update event.
+type FuturesPosition struct {
+	Symbol              string                `json:"s"`
+	PositionAmt         string                `json:"pa"`
+	EntryPrice          string                `json:"ep"`
+	BreakEvenPrice      string                `json:"bep"`
+	AccumulatedRealized string                `json:"cr"` // (pre-fee) accumulated realized
+	UnrealizedPnL       string                `json:"up"`
+	MarginType          string                `json:"mt"` // "isolated" or "cross"
+	IsolatedWallet      string                `json:"iw"` // if isolated position
+	PositionSide        common.BIPositionSide `json:"ps"`
+}
+
+// FuturesAccountUpdate holds the balances and positions of a futures account
+// update event. Only balances and positions that changed are included.
+type FuturesAccountUpdate struct {
+	Reason    string            `json:"m"` // event reason type (e.g. ORDER, FUNDING_FEE)
+	Balances  []FuturesBalance  `json:"B"`
+	Positions []FuturesPosition `json:"P"`
+}
+
+// FuturesAccountUpdateEvent is an ACCOUNT_UPDATE event.
+type FuturesAccountUpdateEvent struct {
+	StreamBaseEvent
+	TSSTransact common.TSNano        `json:"T"`
+	Update      FuturesAccountUpdate `json:"a"`
+}
+
+// FuturesOrderUpdate is the order of a futures order update event.
+type FuturesOrderUpdate struct {
+	Symbol        string                    `json:"s"`
+	ClientOrderID string                    `json:"c"`
+	Side          common.BIOrderSide        `json:"S"`
+	OrderType     common.BIOrderType        `json:"o"`
+	TimeInForce   common.BIOrderTimeInForce `json:"f"`
+	OrigOrderType common.BIOrderType        `json:"ot"`
+	PositionSide  common.BIPositionSide     `json:"ps"`
+	WorkingType   common.BIWorkingType      `json:"wt"`
+
+	ExecutionStatus common.BIExecutionType `json:"x"` // current execution type
+	OrderStatus     common.BIOrderStatus   `json:"X"`
+
+	OrderID int64 `json:"i"`
+	TradeID int64 `json:"t"`
+
+	Price             string `json:"p"`
+	AvgPrice          string `json:"ap"`
+	StopPrice         string `json:"sp"`
+	LastExecutedPrice string `json:"L"`
+	ActivationPrice   string `json:"AP"` // only for TRAILING_STOP_MARKET orders
+	CallbackRate      string `json:"cr"` // only for TRAILING_STOP_MARKET orders
+
+	Qty             string `json:"q"`
+	LastExecutedQty string `json:"l"`
+	CumFilledQty    string `json:"z"`
+
+	CommissionAmount string `json:"n"`
+	CommissionAsset  string `json:"N"`
+	RealizedProfit   string `json:"rp"`
+
+	BidsNotional string `json:"b"`
+	AsksNotional string `json:"a"`
+
+	TSSTransact common.TSNano `json:"T"`   // order trade time
+	TSSGoodTill common.TSNano `json:"gtd"` // only for GTD orders
+
+	IsMaker         bool `json:"m"`
+	IsReduceOnly    bool `json:"R"`
+	IsClosePosition bool `json:"cp"` // if close-all (conditional orders)
+	PriceProtect    bool `json:"pP"`
+
+	SelfTradePreventionMode common.BISelfTradePreventionMode `json:"V"`
+	PriceMatch              string                           `json:"pm"`
+
+	Ignore1 interface{} `json:"si"`
+	Ignore2 interface{} `json:"ss"`
+}
+
+// FuturesOrderUpdateEvent is an ORDER_TRADE_UPDATE event.
+type FuturesOrderUpdateEvent struct {
+	StreamBaseEvent
+	TSSTransact common.TSNano      `json:"T"`
+	Order       FuturesOrderUpdate `json:"o"`
+}
+
+// FuturesMarginCallPosition is a position of a futures margin call event.
+type FuturesMarginCallPosition struct {
+	Symbol            string                `json:"s"`
+	PositionSide      common.BIPositionSide `json:"ps"`
+	PositionAmt       string                `json:"pa"`
+	MarginType        string                `json:"mt"`
+	IsolatedWallet    string                `json:"iw"` // if isolated position
+	MarkPrice         string                `json:"mp"`
+	UnrealizedPnL     string                `json:"up"`
+	MaintenanceMargin string                `json:"mm"` // maintenance margin required
+}
+
+// FuturesMarginCallEvent is a MARGIN_CALL event.
+type FuturesMarginCallEvent struct {
+	StreamBaseEvent
+	CrossWalletBalance string                      `json:"cw"` // only pushed with crossed position margin call
+	Positions          []FuturesMarginCallPosition `json:"p"`
+}
+
+// FuturesLeverageUpdate is the leverage update of an account config update event.
+type FuturesLeverageUpdate struct {
+	Symbol   string `json:"s"`
+	Leverage int    `json:"l"`
+}
+
+// FuturesMultiAssetsModeUpdate is the multi-assets mode update of an account
+// config update event.
+type FuturesMultiAssetsModeUpdate struct {
+	MultiAssetsMode bool `json:"j"`
+}
+
+// FuturesAccountConfigUpdateEvent is an ACCOUNT_CONFIG_UPDATE event. Either
+// LeverageUpdate or MultiAssetsModeUpdate is set.
+type FuturesAccountConfigUpdateEvent struct {
+	StreamBaseEvent
+	TSSTransact           common.TSNano                 `json:"T"`
+	LeverageUpdate        *FuturesLeverageUpdate        `json:"ac"`
+	MultiAssetsModeUpdate *FuturesMultiAssetsModeUpdate `json:"ai"`
+}
+
+// FuturesTradeLiteEvent is a TRADE_LITE event. It is a low latency variant
+// of the ORDER_TRADE_UPDATE event, that is only pushed for trades.
+type FuturesTradeLiteEvent struct {
+	StreamBaseEvent
+	TSSTransact       common.TSNano      `json:"T"`
+	Symbol            string             `json:"s"`
+	Qty               string             `json:"q"`
+	Price             string             `json:"p"`
+	IsMaker           bool               `json:"m"`
+	ClientOrderID     string             `json:"c"`
+	Side              common.BIOrderSide `json:"S"`
+	LastExecutedPrice string             `json:"L"`
+	LastExecutedQty   string             `json:"l"`
+	TradeID           int64              `json:"t"`
+	OrderID           int64              `json:"i"`
+}
+
+// FuturesListenKeyExpiredEvent is a listenKeyExpired event for futures markets.
+type FuturesListenKeyExpiredEvent = ListenKeyExpiredEvent
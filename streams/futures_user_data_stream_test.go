@@ -0,0 +1,234 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	fapiAccountUpdateMsg = []byte(`{
+  "e": "ACCOUNT_UPDATE",
+  "E": 1564745798939,
+  "T": 1564745798938,
+  "a": {
+    "m": "ORDER",
+    "B": [
+      {"a": "USDT", "wb": "122624.12345678", "cw": "100.12345678", "bc": "50.12345678"}
+    ],
+    "P": [
+      {"s": "BTCUSDT", "pa": "0", "ep": "0.00000", "bep": "0", "cr": "200", "up": "0", "mt": "isolated", "iw": "0.00000000", "ps": "BOTH"}
+    ]
+  }
+}`)
+
+	fapiAccountUpdateTarget = &FuturesAccountUpdateEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "ACCOUNT_UPDATE", TSSEvent: common.NewTSNano(1564745798939)},
+		TSSTransact:     common.NewTSNano(1564745798938),
+		Update: FuturesAccountUpdate{
+			Reason: "ORDER",
+			Balances: []FuturesBalance{
+				{Asset: "USDT", WalletBalance: "122624.12345678", CrossWalletBalance: "100.12345678", BalanceChange: "50.12345678"},
+			},
+			Positions: []FuturesPosition{
+				{
+					Symbol: "BTCUSDT", PositionAmt: "0", EntryPrice: "0.00000", BreakEvenPrice: "0", AccumulatedRealized: "200",
+					UnrealizedPnL: "0", MarginType: "isolated", IsolatedWallet: "0.00000000", PositionSide: common.PositionSideBoth,
+				},
+			},
+		},
+	}
+
+	fapiOrderUpdateMsg = []byte(`{
+  "e": "ORDER_TRADE_UPDATE",
+  "E": 1568879465651,
+  "T": 1568879465650,
+  "o": {
+    "s": "BTCUSDT",
+    "c": "TEST",
+    "S": "SELL",
+    "o": "TRAILING_STOP_MARKET",
+    "f": "GTC",
+    "q": "0.001",
+    "p": "0",
+    "ap": "0",
+    "sp": "7103.04",
+    "x": "NEW",
+    "X": "NEW",
+    "i": 8886774,
+    "l": "0",
+    "z": "0",
+    "L": "0",
+    "N": "USDT",
+    "n": "0",
+    "T": 1568879465650,
+    "t": 0,
+    "b": "0",
+    "a": "9.91",
+    "m": false,
+    "R": false,
+    "wt": "CONTRACT_PRICE",
+    "ot": "TRAILING_STOP_MARKET",
+    "ps": "LONG",
+    "cp": false,
+    "AP": "7476.89",
+    "cr": "5.0",
+    "pP": false,
+    "si": 0,
+    "ss": 0,
+    "rp": "0",
+    "V": "EXPIRE_TAKER",
+    "pm": "OPPONENT",
+    "gtd": 0
+  }
+}`)
+
+	fapiOrderUpdateTarget = &FuturesOrderUpdateEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "ORDER_TRADE_UPDATE", TSSEvent: common.NewTSNano(1568879465651)},
+		TSSTransact:     common.NewTSNano(1568879465650),
+		Order: FuturesOrderUpdate{
+			Symbol:                  "BTCUSDT",
+			ClientOrderID:           "TEST",
+			Side:                    common.OrderSideSell,
+			OrderType:               common.OrderTypeTrailingStopMarket,
+			TimeInForce:             common.OrderTimeInForceGTC,
+			OrigOrderType:           common.OrderTypeTrailingStopMarket,
+			PositionSide:            common.PositionSideLong,
+			WorkingType:             common.WorkingTypeContractPrice,
+			ExecutionStatus:         common.ExecutionTypeNew,
+			OrderStatus:             common.OrderStatusNew,
+			OrderID:                 8886774,
+			TradeID:                 0,
+			Price:                   "0",
+			AvgPrice:                "0",
+			StopPrice:               "7103.04",
+			LastExecutedPrice:       "0",
+			ActivationPrice:         "7476.89",
+			CallbackRate:            "5.0",
+			Qty:                     "0.001",
+			LastExecutedQty:         "0",
+			CumFilledQty:            "0",
+			CommissionAmount:        "0",
+			CommissionAsset:         "USDT",
+			RealizedProfit:          "0",
+			BidsNotional:            "0",
+			AsksNotional:            "9.91",
+			TSSTransact:             common.NewTSNano(1568879465650),
+			TSSGoodTill:             common.NewTSNano(0),
+			SelfTradePreventionMode: common.SelfTradePreventionModeExpireTaker,
+			PriceMatch:              "OPPONENT",
+			Ignore1:                 float64(0),
+			Ignore2:                 float64(0),
+		},
+	}
+
+	fapiMarginCallMsg = []byte(`{
+  "e": "MARGIN_CALL",
+  "E": 1587727187525,
+  "cw": "3.16812045",
+  "p": [
+    {"s": "ETHUSDT", "ps": "LONG", "pa": "1.327", "mt": "CROSSED", "iw": "0", "mp": "187.17127", "up": "-1.166074", "mm": "1.614445"}
+  ]
+}`)
+
+	fapiMarginCallTarget = &FuturesMarginCallEvent{
+		StreamBaseEvent:    StreamBaseEvent{EventType: "MARGIN_CALL", TSSEvent: common.NewTSNano(1587727187525)},
+		CrossWalletBalance: "3.16812045",
+		Positions: []FuturesMarginCallPosition{
+			{
+				Symbol: "ETHUSDT", PositionSide: common.PositionSideLong, PositionAmt: "1.327", MarginType: "CROSSED",
+				IsolatedWallet: "0", MarkPrice: "187.17127", UnrealizedPnL: "-1.166074", MaintenanceMargin: "1.614445",
+			},
+		},
+	}
+
+	fapiAccountConfigUpdateMsg = []byte(`{"e": "ACCOUNT_CONFIG_UPDATE", "E": 1611646737479, "T": 1611646737476, "ac": {"s": "BTCUSDT", "l": 25}}`)
+
+	fapiAccountConfigUpdateTarget = &FuturesAccountConfigUpdateEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "ACCOUNT_CONFIG_UPDATE", TSSEvent: common.NewTSNano(1611646737479)},
+		TSSTransact:     common.NewTSNano(1611646737476),
+		LeverageUpdate:  &FuturesLeverageUpdate{Symbol: "BTCUSDT", Leverage: 25},
+	}
+
+	fapiTradeLiteMsg = []byte(`{
+  "e": "TRADE_LITE",
+  "E": 1721895408092,
+  "T": 1721895408214,
+  "s": "BTCUSDT",
+  "q": "0.001",
+  "p": "0",
+  "m": false,
+  "c": "z8hcUoOsqEdKMeKPSABslD",
+  "S": "BUY",
+  "L": "64089.20",
+  "l": "0.040",
+  "t": 109100866,
+  "i": 8886774
+}`)
+
+	fapiTradeLiteTarget = &FuturesTradeLiteEvent{
+		StreamBaseEvent:   StreamBaseEvent{EventType: "TRADE_LITE", TSSEvent: common.NewTSNano(1721895408092)},
+		TSSTransact:       common.NewTSNano(1721895408214),
+		Symbol:            "BTCUSDT",
+		Qty:               "0.001",
+		Price:             "0",
+		IsMaker:           false,
+		ClientOrderID:     "z8hcUoOsqEdKMeKPSABslD",
+		Side:              common.OrderSideBuy,
+		LastExecutedPrice: "64089.20",
+		LastExecutedQty:   "0.040",
+		TradeID:           109100866,
+		OrderID:           8886774,
+	}
+
+	// NOTE: E is a string in listenKeyExpired events.
+	fapiListenKeyExpiredMsg = []byte(`{"e": "listenKeyExpired", "E": "1736996475556", "listenKey": "WsCMN0a4KHUPTQuX6IUnqEZfB1inxmv1qR4kbf1LuEjur5VdbzqvyxqG9TSjVVxv"}`)
+
+	fapiListenKeyExpiredTarget = &FuturesListenKeyExpiredEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "listenKeyExpired", TSSEvent: common.NewTSNano(1736996475556)},
+		ListenKey:       "WsCMN0a4KHUPTQuX6IUnqEZfB1inxmv1qR4kbf1LuEjur5VdbzqvyxqG9TSjVVxv",
+	}
+)
+
+func TestUnmarshalFuturesOrderUpdateEvent(t *testing.T) {
+	event := &FuturesOrderUpdateEvent{}
+	err := json.Unmarshal(fapiOrderUpdateMsg, event)
+	assert.Nil(t, err)
+	assert.Equal(t, fapiOrderUpdateTarget, event)
+}
+
+func TestFuturesUserDataStreamHandler(t *testing.T) {
+	handler := newFuturesUserDataStreamHandler(log.NewEntry(log.New()))
+	assert.NotNil(t, handler)
+
+	assert.Nil(t, handler.HandleRecv(fapiAccountUpdateMsg, 0, 0))
+	assert.Equal(t, fapiAccountUpdateTarget, <-handler.AccountUpdateEventChan)
+
+	assert.Nil(t, handler.HandleRecv(fapiOrderUpdateMsg, 0, 0))
+	assert.Equal(t, fapiOrderUpdateTarget, <-handler.OrderUpdateEventChan)
+
+	assert.Nil(t, handler.HandleRecv(fapiMarginCallMsg, 0, 0))
+	assert.Equal(t, fapiMarginCallTarget, <-handler.MarginCallEventChan)
+
+	assert.Nil(t, handler.HandleRecv(fapiAccountConfigUpdateMsg, 0, 0))
+	assert.Equal(t, fapiAccountConfigUpdateTarget, <-handler.AccountConfigUpdateEventChan)
+
+	assert.Nil(t, handler.HandleRecv(fapiTradeLiteMsg, 0, 0))
+	assert.Equal(t, fapiTradeLiteTarget, <-handler.TradeLiteEventChan)
+
+	assert.Nil(t, handler.HandleRecv(fapiListenKeyExpiredMsg, 0, 0))
+	assert.Equal(t, fapiListenKeyExpiredTarget, <-handler.ListenKeyExpiredEventChan)
+
+	// unknown event types are not fatal, and are sent to the RawEventChan
+	assert.Nil(t, handler.HandleRecv(unexpectedMsg, 0, 0))
+	assert.Equal(t, "unexpected", (<-handler.RawEventChan).EventType)
+}
+
+func TestFuturesUserDataStreamPath(t *testing.T) {
+	listenKey := "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"
+	s := &FuturesUserDataStream{ListenKey: &listenKey}
+	assert.Equal(t, "/ws/"+listenKey, s.path())
+}
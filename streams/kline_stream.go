@@ -0,0 +1,181 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Shared KlineStream =============================== */
+
+// KlineStream is a shared Stream implementation for kline streams.
+// It includes a Stream, a handler for market streams, a symbol and an
+// interval for WebSocket communication.
+type KlineStream[E Event] struct {
+	common.Stream
+	Handler  *MarketStreamHandler[E]
+	WSSymbol *string
+	Interval *common.BIKlineInterval
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (wsSymbol and interval).
+func (s *KlineStream[E]) SetSymbol(restSymbol string) *KlineStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.setPathFuncIfReady()
+	return s
+}
+
+// SetInterval sets the kline interval that is used in generating the path
+// for the stream. It also sets the path function for the stream, if all
+// path params are set (wsSymbol and interval).
+func (s *KlineStream[E]) SetInterval(interval common.BIKlineInterval) *KlineStream[E] {
+	s.Interval = &interval
+	s.setPathFuncIfReady()
+	return s
+}
+
+// setPathFuncIfReady sets the path function once all path params are set.
+func (s *KlineStream[E]) setPathFuncIfReady() {
+	if s.WSSymbol != nil && s.Interval != nil {
+		s.SetPathFunc(s.path)
+	}
+}
+
+// path returns the path for the kline stream. It is used as the path
+// function for the stream.
+func (s *KlineStream[E]) path() string {
+	path := "/ws/%s@kline_%s"
+	return fmt.Sprintf(path, *s.WSSymbol, *s.Interval)
+}
+
+/* ==================== Kline ============================================ */
+
+// Kline is the kline (candlestick) that is sent with kline and continuous
+// kline events.
+type Kline struct {
+	TSSOpen             common.TSNano          `json:"t"`
+	TSSClose            common.TSNano          `json:"T"`
+	Symbol              string                 `json:"s"` // not sent with continuous klines
+	Interval            common.BIKlineInterval `json:"i"`
+	FirstTradeID        int64                  `json:"f"`
+	LastTradeID         int64                  `json:"L"`
+	Open                string                 `json:"o"`
+	Close               string                 `json:"c"`
+	High                string                 `json:"h"`
+	Low                 string                 `json:"l"`
+	Volume              string                 `json:"v"`
+	NumberOfTrades      int64                  `json:"n"`
+	IsClosed            bool                   `json:"x"`
+	QuoteVolume         string                 `json:"q"`
+	TakerBuyVolume      string                 `json:"V"`
+	TakerBuyQuoteVolume string                 `json:"Q"`
+	Ignore              interface{}            `json:"B"`
+}
+
+// KlineEvent is a kline event for spot/margin and futures streams.
+type KlineEvent struct {
+	StreamBaseEvent
+	Symbol string `json:"s"`
+	Kline  Kline  `json:"k"`
+}
+
+/* ==================== SpotMargin ======================================= */
+
+// SpotMarginKlineHandler is a handler for spot/margin kline streams.
+type SpotMarginKlineHandler = MarketStreamHandler[*KlineEvent]
+
+// newSpotMarginKlineHandler creates a new SpotMarginKlineHandler.
+func newSpotMarginKlineHandler(logger *log.Entry) *SpotMarginKlineHandler {
+	return newMarketStreamHandler[*KlineEvent](logger)
+}
+
+// SpotMarginKlineStream is a stream for spot/margin kline streams.
+type SpotMarginKlineStream = KlineStream[*KlineEvent]
+
+/* ==================== Futures ========================================== */
+
+// FuturesKlineHandler is a handler for futures kline streams.
+type FuturesKlineHandler = MarketStreamHandler[*KlineEvent]
+
+// newFuturesKlineHandler creates a new FuturesKlineHandler.
+func newFuturesKlineHandler(logger *log.Entry) *FuturesKlineHandler {
+	return newMarketStreamHandler[*KlineEvent](logger)
+}
+
+// FuturesKlineStream is a stream for futures kline streams.
+type FuturesKlineStream = KlineStream[*KlineEvent]
+
+/* ==================== Futures ContinuousKline ========================== */
+
+// FuturesContinuousKlineStream is a stream for futures continuous contract
+// kline streams (<pair>_<contractType>@continuousKline_<interval>).
+type FuturesContinuousKlineStream struct {
+	common.Stream
+	Handler      *FuturesContinuousKlineHandler
+	WSPair       *string
+	ContractType *common.BIContractType
+	Interval     *common.BIKlineInterval
+}
+
+// SetPair sets the wsPair that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (wsPair, contractType and interval).
+func (s *FuturesContinuousKlineStream) SetPair(restPair string) *FuturesContinuousKlineStream {
+	wsPair := strings.ToLower(restPair)
+	s.WSPair = &wsPair
+	s.setPathFuncIfReady()
+	return s
+}
+
+// SetContractType sets the contract type that is used in generating the
+// path for the stream. It also sets the path function for the stream, if all
+// path params are set (wsPair, contractType and interval).
+func (s *FuturesContinuousKlineStream) SetContractType(contractType common.BIContractType) *FuturesContinuousKlineStream {
+	s.ContractType = &contractType
+	s.setPathFuncIfReady()
+	return s
+}
+
+// SetInterval sets the kline interval that is used in generating the path
+// for the stream. It also sets the path function for the stream, if all
+// path params are set (wsPair, contractType and interval).
+func (s *FuturesContinuousKlineStream) SetInterval(interval common.BIKlineInterval) *FuturesContinuousKlineStream {
+	s.Interval = &interval
+	s.setPathFuncIfReady()
+	return s
+}
+
+// setPathFuncIfReady sets the path function once all path params are set.
+func (s *FuturesContinuousKlineStream) setPathFuncIfReady() {
+	if s.WSPair != nil && s.ContractType != nil && s.Interval != nil {
+		s.SetPathFunc(s.path)
+	}
+}
+
+// path returns the path for the continuous kline stream. It is used as the
+// path function for the stream.
+func (s *FuturesContinuousKlineStream) path() string {
+	path := "/ws/%s_%s@continuousKline_%s"
+	return fmt.Sprintf(path, *s.WSPair, strings.ToLower(string(*s.ContractType)), *s.Interval)
+}
+
+// FuturesContinuousKlineEvent is a continuous contract kline event.
+type FuturesContinuousKlineEvent struct {
+	StreamBaseEvent
+	Pair         string                `json:"ps"`
+	ContractType common.BIContractType `json:"ct"`
+	Kline        Kline                 `json:"k"`
+}
+
+// FuturesContinuousKlineHandler is a handler for futures continuous kline streams.
+type FuturesContinuousKlineHandler = MarketStreamHandler[*FuturesContinuousKlineEvent]
+
+// newFuturesContinuousKlineHandler creates a new FuturesContinuousKlineHandler.
+func newFuturesContinuousKlineHandler(logger *log.Entry) *FuturesContinuousKlineHandler {
+	return newMarketStreamHandler[*FuturesContinuousKlineEvent](logger)
+}
@@ -0,0 +1,141 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiKlineMsg = []byte(`{
+  "e": "kline",
+  "E": 1672515782136,
+  "s": "BNBBTC",
+  "k": {
+    "t": 1672515780000,
+    "T": 1672515839999,
+    "s": "BNBBTC",
+    "i": "1m",
+    "f": 100,
+    "L": 200,
+    "o": "0.0010",
+    "c": "0.0020",
+    "h": "0.0025",
+    "l": "0.0015",
+    "v": "1000",
+    "n": 100,
+    "x": false,
+    "q": "1.0000",
+    "V": "500",
+    "Q": "0.500",
+    "B": "123456"
+  }
+}`)
+
+	apiKlineTarget = &KlineEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "kline", TSSEvent: common.NewTSNano(1672515782136)},
+		Symbol:          "BNBBTC",
+		Kline: Kline{
+			TSSOpen:             common.NewTSNano(1672515780000),
+			TSSClose:            common.NewTSNano(1672515839999),
+			Symbol:              "BNBBTC",
+			Interval:            common.KlineInterval1m,
+			FirstTradeID:        100,
+			LastTradeID:         200,
+			Open:                "0.0010",
+			Close:               "0.0020",
+			High:                "0.0025",
+			Low:                 "0.0015",
+			Volume:              "1000",
+			NumberOfTrades:      100,
+			IsClosed:            false,
+			QuoteVolume:         "1.0000",
+			TakerBuyVolume:      "500",
+			TakerBuyQuoteVolume: "0.500",
+			Ignore:              "123456",
+		},
+	}
+
+	fapiContinuousKlineMsg = []byte(`{
+  "e": "continuous_kline",
+  "E": 1607443058651,
+  "ps": "BTCUSDT",
+  "ct": "PERPETUAL",
+  "k": {
+    "t": 1607443020000,
+    "T": 1607443079999,
+    "i": "1m",
+    "f": 116467658886,
+    "L": 116468012423,
+    "o": "18787.00",
+    "c": "18804.04",
+    "h": "18804.04",
+    "l": "18786.54",
+    "v": "197.664",
+    "n": 543,
+    "x": true,
+    "q": "3715253.19494",
+    "V": "184.769",
+    "Q": "3472925.84746",
+    "B": "0"
+  }
+}`)
+
+	fapiContinuousKlineTarget = &FuturesContinuousKlineEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "continuous_kline", TSSEvent: common.NewTSNano(1607443058651)},
+		Pair:            "BTCUSDT",
+		ContractType:    common.ContractTypePerpetual,
+		Kline: Kline{
+			TSSOpen:             common.NewTSNano(1607443020000),
+			TSSClose:            common.NewTSNano(1607443079999),
+			Interval:            common.KlineInterval1m,
+			FirstTradeID:        116467658886,
+			LastTradeID:         116468012423,
+			Open:                "18787.00",
+			Close:               "18804.04",
+			High:                "18804.04",
+			Low:                 "18786.54",
+			Volume:              "197.664",
+			NumberOfTrades:      543,
+			IsClosed:            true,
+			QuoteVolume:         "3715253.19494",
+			TakerBuyVolume:      "184.769",
+			TakerBuyQuoteVolume: "3472925.84746",
+			Ignore:              "0",
+		},
+	}
+)
+
+func TestUnmarshalKlineEvent(t *testing.T) {
+	apiEvent := &KlineEvent{}
+	err := json.Unmarshal(apiKlineMsg, apiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, apiKlineTarget, apiEvent)
+
+	fapiEvent := &FuturesContinuousKlineEvent{}
+	err = json.Unmarshal(fapiContinuousKlineMsg, fapiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, fapiContinuousKlineTarget, fapiEvent)
+}
+
+func TestKlineHandler(t *testing.T) {
+	handler := newFuturesContinuousKlineHandler(nil)
+	assert.NotNil(t, handler)
+
+	handler.HandleRecv(fapiContinuousKlineMsg, 0, 0)
+	event := <-handler.EventChan
+	assert.IsType(t, &FuturesContinuousKlineEvent{}, event)
+	assert.Equal(t, fapiContinuousKlineTarget, event)
+}
+
+func TestKlinePaths(t *testing.T) {
+	symbol, interval := "bnbbtc", common.KlineInterval1s
+	s := &SpotMarginKlineStream{WSSymbol: &symbol, Interval: &interval}
+	assert.Equal(t, "/ws/bnbbtc@kline_1s", s.path())
+
+	pair, ct, interval := "btcusdt", common.ContractTypeCurrentQuarter, common.KlineInterval1M
+	cs := &FuturesContinuousKlineStream{WSPair: &pair, ContractType: &ct, Interval: &interval}
+	assert.Equal(t, "/ws/btcusdt_current_quarter@continuousKline_1M", cs.path())
+}
@@ -0,0 +1,121 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Shared PartialDepthStream ======================== */
+
+// partialDepthLevels are the allowed number of levels of partial depth streams.
+var partialDepthLevels = []int{5, 10, 20}
+
+// PartialDepthStream is a shared Stream implementation for partial book depth
+// streams (<symbol>@depth<levels>@<speed>ms). It includes a Stream, a handler
+// for market streams, a symbol, the number of levels and the update speed
+// for WebSocket communication.
+type PartialDepthStream[E Event] struct {
+	common.Stream
+	Handler      *MarketStreamHandler[E]
+	WSSymbol     *string
+	Levels       *int
+	UpdateSpeed  *int
+	endpointType common.BIEndpointType
+	logger       *log.Entry
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (wsSymbol and levels).
+func (s *PartialDepthStream[E]) SetSymbol(restSymbol string) *PartialDepthStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.setPathFuncIfReady()
+	return s
+}
+
+// SetLevels sets the number of levels (5, 10 or 20). Any other number is
+// ignored and an error is logged. It also sets the path function for the
+// stream, if all path params are set (wsSymbol and levels).
+func (s *PartialDepthStream[E]) SetLevels(levels int) *PartialDepthStream[E] {
+	if !containsInt(partialDepthLevels, levels) {
+		s.logger.WithField("levels", levels).Errorf("SetLevels: levels must be one of %v", partialDepthLevels)
+		return s
+	}
+	s.Levels = &levels
+	s.setPathFuncIfReady()
+	return s
+}
+
+// SetUpdateSpeed sets the update speed (milliseconds). The update speed must
+// be supported by the stream's market (see depthUpdateSpeeds), otherwise it
+// is ignored and an error is logged. It is ignored while the stream is running.
+func (s *PartialDepthStream[E]) SetUpdateSpeed(updateSpeed int) *PartialDepthStream[E] {
+	setDepthUpdateSpeed(s.Stream, s.UpdateSpeed, s.endpointType, updateSpeed, s.logger)
+	return s
+}
+
+// setPathFuncIfReady sets the path function once all path params are set.
+func (s *PartialDepthStream[E]) setPathFuncIfReady() {
+	if s.WSSymbol != nil && s.Levels != nil {
+		s.SetPathFunc(s.path)
+	}
+}
+
+// path returns the path for the partial depth stream. It is used as the path
+// function for the stream.
+func (s *PartialDepthStream[E]) path() string {
+	path := "/ws/%s@depth%d%s"
+	return fmt.Sprintf(path, *s.WSSymbol, *s.Levels, depthUpdateSpeedSuffix(s.endpointType, *s.UpdateSpeed))
+}
+
+/* ==================== SpotMargin ======================================= */
+
+// SpotMarginPartialDepthEvent is a partial depth event for spot and margin
+// markets.
+// NOTE: spot partial depth events have no event type, event time or symbol.
+type SpotMarginPartialDepthEvent struct {
+	StreamBaseEvent
+	LastUpdateID int64   `json:"lastUpdateId"`
+	Bids         []Level `json:"bids"`
+	Asks         []Level `json:"asks"`
+}
+
+// SpotMarginPartialDepthHandler is a handler for spot and margin partial depth streams.
+type SpotMarginPartialDepthHandler = MarketStreamHandler[*SpotMarginPartialDepthEvent]
+
+// newSpotMarginPartialDepthHandler creates a new SpotMarginPartialDepthHandler.
+func newSpotMarginPartialDepthHandler(logger *log.Entry) *SpotMarginPartialDepthHandler {
+	return newMarketStreamHandler[*SpotMarginPartialDepthEvent](logger)
+}
+
+// SpotMarginPartialDepthStream is a partial depth stream for spot and margin markets.
+type SpotMarginPartialDepthStream = PartialDepthStream[*SpotMarginPartialDepthEvent]
+
+/* ==================== Futures ========================================== */
+
+// FuturesPartialDepthEvent is a partial depth event for futures markets.
+type FuturesPartialDepthEvent struct {
+	StreamBaseEvent
+	TSSTransact common.TSNano `json:"T"`
+	Symbol      string        `json:"s"`
+	FirstID     int64         `json:"U"`
+	FinalID     int64         `json:"u"`
+	LastFinalID int64         `json:"pu"`
+	Bids        []Level       `json:"b"`
+	Asks        []Level       `json:"a"`
+}
+
+// FuturesPartialDepthHandler is a handler for futures partial depth streams.
+type FuturesPartialDepthHandler = MarketStreamHandler[*FuturesPartialDepthEvent]
+
+// newFuturesPartialDepthHandler creates a new FuturesPartialDepthHandler.
+func newFuturesPartialDepthHandler(logger *log.Entry) *FuturesPartialDepthHandler {
+	return newMarketStreamHandler[*FuturesPartialDepthEvent](logger)
+}
+
+// FuturesPartialDepthStream is a partial depth stream for futures markets.
+type FuturesPartialDepthStream = PartialDepthStream[*FuturesPartialDepthEvent]
@@ -0,0 +1,91 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiPartialDepthMsg = []byte(`{
+  "lastUpdateId": 160,
+  "bids": [ [ "0.0024", "10" ] ],
+  "asks": [ [ "0.0026", "100" ], [ "0.0027", "5" ] ]
+}`)
+
+	apiPartialDepthTarget = &SpotMarginPartialDepthEvent{
+		LastUpdateID: 160,
+		Bids:         []Level{{Price: "0.0024", Qty: "10"}},
+		Asks:         []Level{{Price: "0.0026", Qty: "100"}, {Price: "0.0027", Qty: "5"}},
+	}
+
+	fapiPartialDepthMsg = []byte(`{
+  "e": "depthUpdate",
+  "E": 1571889248277,
+  "T": 1571889248276,
+  "s": "BTCUSDT",
+  "U": 390497796,
+  "u": 390497878,
+  "pu": 390497794,
+  "b": [ [ "7403.89", "0.002" ] ],
+  "a": [ [ "7405.96", "3.340" ] ]
+}`)
+
+	fapiPartialDepthTarget = &FuturesPartialDepthEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "depthUpdate", TSSEvent: common.NewTSNano(1571889248277)},
+		TSSTransact:     common.NewTSNano(1571889248276),
+		Symbol:          "BTCUSDT",
+		FirstID:         390497796,
+		FinalID:         390497878,
+		LastFinalID:     390497794,
+		Bids:            []Level{{Price: "7403.89", Qty: "0.002"}},
+		Asks:            []Level{{Price: "7405.96", Qty: "3.340"}},
+	}
+)
+
+func TestUnmarshalPartialDepthEvent(t *testing.T) {
+	fapiEvent := &FuturesPartialDepthEvent{}
+	err := json.Unmarshal(fapiPartialDepthMsg, fapiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, fapiPartialDepthTarget, fapiEvent)
+}
+
+func TestPartialDepthHandler(t *testing.T) {
+	handler := newSpotMarginPartialDepthHandler(nil)
+	assert.NotNil(t, handler)
+
+	// spot partial depth events have no event type, this must not be an error
+	wshErr := handler.HandleRecv(apiPartialDepthMsg, 0, 0)
+	assert.Nil(t, wshErr)
+	event := <-handler.EventChan
+	assert.Equal(t, apiPartialDepthTarget, event)
+}
+
+func TestPartialDepthPathAndUpdateSpeed(t *testing.T) {
+	symbol, levels, updateSpeed := "btcusdt", 10, 250
+	s := &FuturesPartialDepthStream{
+		Stream:       &mockStream{},
+		WSSymbol:     &symbol,
+		Levels:       &levels,
+		UpdateSpeed:  &updateSpeed,
+		endpointType: common.EndpointTypeFAPI,
+		logger:       log.NewEntry(log.StandardLogger()),
+	}
+	assert.Equal(t, "/ws/btcusdt@depth10", s.path())
+
+	s.SetUpdateSpeed(500)
+	assert.Equal(t, "/ws/btcusdt@depth10@500ms", s.path())
+
+	// 1000ms is only supported by spot, the update speed is not changed
+	s.SetUpdateSpeed(1000)
+	assert.Equal(t, 500, *s.UpdateSpeed)
+
+	s.endpointType = common.EndpointTypeAPI
+	s.SetUpdateSpeed(1000)
+	assert.Equal(t, "/ws/btcusdt@depth10", s.path())
+	s.SetUpdateSpeed(100)
+	assert.Equal(t, "/ws/btcusdt@depth10@100ms", s.path())
+}
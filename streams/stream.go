@@ -16,13 +16,87 @@ const (
 
 var APIStreams = map[string]common.StreamDefinition{
 	"aggTrades": {
+		StreamType:   common.StreamTypeAggTrades,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointAPI,
 		EndpointType: common.EndpointTypeAPI,
 		SecurityType: common.WSSecurityTypeNone,
 		UpdateSpeed:  0, // Real-time
 	},
+	"trade": {
+		StreamType:   common.StreamTypeTrade,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time
+	},
+	"kline": {
+		StreamType:   common.StreamTypeKline,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  2000, // 2000ms (1000ms for 1s klines)
+	},
+	"bookTicker": {
+		StreamType:   common.StreamTypeBookTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time
+	},
+	"ticker": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"miniTicker": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"allTickers": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"allMiniTickers": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"rollingWindowTicker": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"partialDepth": {
+		StreamType:   common.StreamTypePartialDepth,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointAPI,
+		EndpointType: common.EndpointTypeAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms (default), 100ms
+	},
 	"depth100ms": {
+		StreamType:   common.StreamTypeDiffDepth,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointAPI,
 		EndpointType: common.EndpointTypeAPI,
@@ -30,6 +104,7 @@ var APIStreams = map[string]common.StreamDefinition{
 		UpdateSpeed:  100, // 100ms
 	},
 	"depth1000ms": {
+		StreamType:   common.StreamTypeDiffDepth,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointAPI,
 		EndpointType: common.EndpointTypeAPI,
@@ -37,6 +112,7 @@ var APIStreams = map[string]common.StreamDefinition{
 		UpdateSpeed:  1000, // 1000ms
 	},
 	"userDataStream": {
+		StreamType:   common.StreamTypeUserData,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointAPI,
 		EndpointType: common.EndpointTypeAPI,
@@ -47,6 +123,7 @@ var APIStreams = map[string]common.StreamDefinition{
 
 var SAPIStreams = map[string]common.StreamDefinition{
 	"userDataStream": {
+		StreamType:   common.StreamTypeUserData,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointAPI,
 		EndpointType: common.EndpointTypeSAPI,
@@ -57,13 +134,159 @@ var SAPIStreams = map[string]common.StreamDefinition{
 
 var FAPIStreams = map[string]common.StreamDefinition{
 	"aggTrades": {
+		StreamType:   common.StreamTypeAggTrades,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time
+	},
+	"trade": {
+		StreamType:   common.StreamTypeTrade,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time
+	},
+	"kline": {
+		StreamType:   common.StreamTypeKline,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  250, // 250ms
+	},
+	"continuousKline": {
+		StreamType:   common.StreamTypeKline,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  250, // 250ms
+	},
+	"bookTicker": {
+		StreamType:   common.StreamTypeBookTicker,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointFAPI,
 		EndpointType: common.EndpointTypeFAPI,
 		SecurityType: common.WSSecurityTypeNone,
 		UpdateSpeed:  0, // Real-time
 	},
+	"allBookTicker": {
+		StreamType:   common.StreamTypeBookTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  5000, // 5s
+	},
+	"ticker": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  2000, // 2000ms
+	},
+	"miniTicker": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  2000, // 2000ms
+	},
+	"allTickers": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"allMiniTickers": {
+		StreamType:   common.StreamTypeTicker,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"partialDepth": {
+		StreamType:   common.StreamTypePartialDepth,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  250, // 250ms (default), 500ms, 100ms
+	},
+	"markPrice": {
+		StreamType:   common.StreamTypeMarkPrice,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  3000, // 3000ms (default), 1000ms
+	},
+	"allMarkPrice": {
+		StreamType:   common.StreamTypeMarkPrice,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  3000, // 3000ms (default), 1000ms
+	},
+	"forceOrder": {
+		StreamType:   common.StreamTypeForceOrder,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time (at most one event per 1000ms)
+	},
+	"allForceOrder": {
+		StreamType:   common.StreamTypeForceOrder,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time (at most one event per 1000ms)
+	},
+	"compositeIndex": {
+		StreamType:   common.StreamTypeCompositeIndex,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  1000, // 1000ms
+	},
+	"contractInfo": {
+		StreamType:   common.StreamTypeContractInfo,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time
+	},
+	"userDataStream": {
+		StreamType:   common.StreamTypeUserData,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  0, // Real-time
+	},
+	"depth250ms": {
+		StreamType:   common.StreamTypeDiffDepth,
+		Scheme:       "wss",
+		Endpoint:     common.WSEndpointFAPI,
+		EndpointType: common.EndpointTypeFAPI,
+		SecurityType: common.WSSecurityTypeNone,
+		UpdateSpeed:  250, // 250ms (default)
+	},
 	"depth100ms": {
+		StreamType:   common.StreamTypeDiffDepth,
 		Scheme:       "wss",
 		Endpoint:     common.WSEndpointFAPI,
 		EndpointType: common.EndpointTypeFAPI,
@@ -74,6 +297,7 @@ var FAPIStreams = map[string]common.StreamDefinition{
 
 var WSAPIStreams = map[string]common.StreamDefinition{
 	"wsAPIStream": {
+		StreamType:   common.StreamTypeWSAPI,
 		Scheme:       "wss",
 		Endpoint:     common.WSAPIEndpointAPI,
 		EndpointType: common.EndpointTypeAPI,
@@ -93,15 +317,12 @@ func NewSpotUserDataStream(wc common.WSClient, logger *log.Entry) *SpotUserDataS
 	}
 }
 
-func NewSpotMarginDiffDepth100Stream(wc common.WSClient, logger *log.Entry) *SpotMarginDiffDepthStream {
-	sm := common.NewStreamMeta(APIStreams["depth100ms"])
-	handler := newSpotMarginDiffDepthHandler(logger.WithField("_caller", "SpotMarginDiffDepthHandler"))
+func NewSpotMarginDiffDepthStream(wc common.WSClient, logger *log.Entry) *SpotMarginDiffDepthStream {
+	return newDiffDepthStream[*SpotMarginDiffDepthEvent](wc, APIStreams["depth1000ms"], "SpotMarginDiffDepth", logger)
+}
 
-	return &SpotMarginDiffDepthStream{
-		Handler:     handler,
-		Stream:      wc.NewStream(sm, handler, logger.WithField("_caller", "SpotMarginDiffDepthStream")),
-		UpdateSpeed: &sm.SD.UpdateSpeed, // hardcoded to 100ms
-	}
+func NewSpotMarginDiffDepth100Stream(wc common.WSClient, logger *log.Entry) *SpotMarginDiffDepthStream {
+	return newDiffDepthStream[*SpotMarginDiffDepthEvent](wc, APIStreams["depth100ms"], "SpotMarginDiffDepth", logger)
 }
 
 func NewSpotMarginAggTradesStream(wc common.WSClient, logger *log.Entry) *SpotMarginAggTradesStream {
@@ -114,6 +335,107 @@ func NewSpotMarginAggTradesStream(wc common.WSClient, logger *log.Entry) *SpotMa
 	}
 }
 
+func NewSpotMarginTradeStream(wc common.WSClient, logger *log.Entry) *SpotMarginTradeStream {
+	sm := common.NewStreamMeta(APIStreams["trade"])
+	handler := newSpotMarginTradeHandler(logger.WithField("_caller", "SpotMarginTradeHandler"))
+
+	return &SpotMarginTradeStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "SpotMarginTradeStream")),
+	}
+}
+
+func NewSpotMarginKlineStream(wc common.WSClient, logger *log.Entry) *SpotMarginKlineStream {
+	sm := common.NewStreamMeta(APIStreams["kline"])
+	handler := newSpotMarginKlineHandler(logger.WithField("_caller", "SpotMarginKlineHandler"))
+
+	return &SpotMarginKlineStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "SpotMarginKlineStream")),
+	}
+}
+
+func NewSpotMarginBookTickerStream(wc common.WSClient, logger *log.Entry) *SpotMarginBookTickerStream {
+	sm := common.NewStreamMeta(APIStreams["bookTicker"])
+	handler := newSpotMarginBookTickerHandler(logger.WithField("_caller", "SpotMarginBookTickerHandler"))
+
+	return &SpotMarginBookTickerStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "SpotMarginBookTickerStream")),
+	}
+}
+
+func NewSpotMarginTickerStream(wc common.WSClient, logger *log.Entry) *SpotMarginTickerStream {
+	return newTickerStream[*SpotMarginTickerEvent](wc, APIStreams["ticker"], "ticker", "SpotMarginTicker", logger)
+}
+
+func NewSpotMarginMiniTickerStream(wc common.WSClient, logger *log.Entry) *SpotMarginMiniTickerStream {
+	return newTickerStream[*SpotMarginMiniTickerEvent](wc, APIStreams["miniTicker"], "miniTicker", "SpotMarginMiniTicker", logger)
+}
+
+func NewSpotMarginAllTickersStream(wc common.WSClient, logger *log.Entry) *SpotMarginAllTickersStream {
+	return newAllTickersStream[*SpotMarginTickerEvent](wc, APIStreams["allTickers"], "ticker", "SpotMarginAllTickers", logger)
+}
+
+func NewSpotMarginAllMiniTickersStream(wc common.WSClient, logger *log.Entry) *SpotMarginAllMiniTickersStream {
+	return newAllTickersStream[*SpotMarginMiniTickerEvent](wc, APIStreams["allMiniTickers"], "miniTicker", "SpotMarginAllMiniTickers", logger)
+}
+
+func NewSpotMarginRollingWindowTickerStream(wc common.WSClient, logger *log.Entry) *SpotMarginRollingWindowTickerStream {
+	sm := common.NewStreamMeta(APIStreams["rollingWindowTicker"])
+	handler := newMarketStreamHandler[*SpotMarginRollingWindowTickerEvent](
+		logger.WithField("_caller", "SpotMarginRollingWindowTickerHandler"))
+
+	return &SpotMarginRollingWindowTickerStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "SpotMarginRollingWindowTickerStream")),
+	}
+}
+
+func NewSpotMarginPartialDepthStream(wc common.WSClient, logger *log.Entry) *SpotMarginPartialDepthStream {
+	sm := common.NewStreamMeta(APIStreams["partialDepth"])
+	handler := newSpotMarginPartialDepthHandler(logger.WithField("_caller", "SpotMarginPartialDepthHandler"))
+	streamLogger := logger.WithField("_caller", "SpotMarginPartialDepthStream")
+
+	return &SpotMarginPartialDepthStream{
+		Handler:      handler,
+		Stream:       wc.NewStream(sm, handler, streamLogger),
+		UpdateSpeed:  &sm.SD.UpdateSpeed,
+		endpointType: sm.SD.EndpointType,
+		logger:       streamLogger,
+	}
+}
+
+func NewSpotUserDataCallbackStream(wc common.WSClient, logger *log.Entry) *SpotUserDataCallbackStream {
+	sm := common.NewStreamMeta(APIStreams["userDataStream"])
+	handler := newCallbackSpotMarginUserDataStreamHandler[
+		*SpotAccountUpdateEvent, *SpotBalanceUpdateEvent, *SpotOrderUpdateEvent,
+	](logger.WithField("_caller", "SpotUserDataCallbackHandler"))
+	return &SpotUserDataCallbackStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "SpotUserDataCallbackStream")),
+	}
+}
+
+func NewSpotMarginDiffDepthCallbackStream(wc common.WSClient, logger *log.Entry) *SpotMarginDiffDepthCallbackStream {
+	return newCallbackDiffDepthStream[*SpotMarginDiffDepthEvent](wc, APIStreams["depth1000ms"], "SpotMarginDiffDepthCallback", logger)
+}
+
+func NewSpotMarginDiffDepth100CallbackStream(wc common.WSClient, logger *log.Entry) *SpotMarginDiffDepthCallbackStream {
+	return newCallbackDiffDepthStream[*SpotMarginDiffDepthEvent](wc, APIStreams["depth100ms"], "SpotMarginDiffDepthCallback", logger)
+}
+
+func NewSpotMarginAggTradesCallbackStream(wc common.WSClient, logger *log.Entry) *SpotMarginAggTradesCallbackStream {
+	sm := common.NewStreamMeta(APIStreams["aggTrades"])
+	handler := newCallbackMarketStreamHandler[*SpotMarginAggTradesEvent](
+		logger.WithField("_caller", "SpotMarginAggTradesCallbackHandler"))
+
+	return &SpotMarginAggTradesCallbackStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "SpotMarginAggTradesCallbackStream")),
+	}
+}
+
 /* ==================== SAPIStreams Factory ============================== */
 
 func NewMarginUserDataStream(wc common.WSClient, logger *log.Entry) *MarginUserDataStream {
@@ -125,17 +447,25 @@ func NewMarginUserDataStream(wc common.WSClient, logger *log.Entry) *MarginUserD
 	}
 }
 
+func NewMarginUserDataCallbackStream(wc common.WSClient, logger *log.Entry) *MarginUserDataCallbackStream {
+	sm := common.NewStreamMeta(SAPIStreams["userDataStream"])
+	handler := newCallbackSpotMarginUserDataStreamHandler[
+		*MarginAccountUpdateEvent, *MarginBalanceUpdateEvent, *MarginOrderUpdateEvent,
+	](logger.WithField("_caller", "MarginUserDataCallbackHandler"))
+	return &MarginUserDataCallbackStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "MarginUserDataCallbackStream")),
+	}
+}
+
 /* ==================== FAPIStreams Factory ============================== */
 
-func NewFuturesDiffDepth100Stream(wc common.WSClient, logger *log.Entry) *FuturesDiffDepthStream {
-	sm := common.NewStreamMeta(FAPIStreams["depth100ms"])
-	handler := newFuturesDiffDepthHandler(logger.WithField("_caller", "FuturesDiffDepthHandler"))
+func NewFuturesDiffDepthStream(wc common.WSClient, logger *log.Entry) *FuturesDiffDepthStream {
+	return newDiffDepthStream[*FuturesDiffDepthEvent](wc, FAPIStreams["depth250ms"], "FuturesDiffDepth", logger)
+}
 
-	return &FuturesDiffDepthStream{
-		Handler:     handler,
-		Stream:      wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesDiffDepthStream")),
-		UpdateSpeed: &sm.SD.UpdateSpeed,
-	}
+func NewFuturesDiffDepth100Stream(wc common.WSClient, logger *log.Entry) *FuturesDiffDepthStream {
+	return newDiffDepthStream[*FuturesDiffDepthEvent](wc, FAPIStreams["depth100ms"], "FuturesDiffDepth", logger)
 }
 
 func NewFuturesAggTradesStream(wc common.WSClient, logger *log.Entry) *FuturesAggTradesStream {
@@ -147,3 +477,195 @@ func NewFuturesAggTradesStream(wc common.WSClient, logger *log.Entry) *FuturesAg
 		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesAggTradesStream")),
 	}
 }
+
+func NewFuturesTradeStream(wc common.WSClient, logger *log.Entry) *FuturesTradeStream {
+	sm := common.NewStreamMeta(FAPIStreams["trade"])
+	handler := newFuturesTradeHandler(logger.WithField("_caller", "FuturesTradeHandler"))
+
+	return &FuturesTradeStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesTradeStream")),
+	}
+}
+
+func NewFuturesKlineStream(wc common.WSClient, logger *log.Entry) *FuturesKlineStream {
+	sm := common.NewStreamMeta(FAPIStreams["kline"])
+	handler := newFuturesKlineHandler(logger.WithField("_caller", "FuturesKlineHandler"))
+
+	return &FuturesKlineStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesKlineStream")),
+	}
+}
+
+func NewFuturesContinuousKlineStream(wc common.WSClient, logger *log.Entry) *FuturesContinuousKlineStream {
+	sm := common.NewStreamMeta(FAPIStreams["continuousKline"])
+	handler := newFuturesContinuousKlineHandler(logger.WithField("_caller", "FuturesContinuousKlineHandler"))
+
+	return &FuturesContinuousKlineStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesContinuousKlineStream")),
+	}
+}
+
+func NewFuturesBookTickerStream(wc common.WSClient, logger *log.Entry) *FuturesBookTickerStream {
+	sm := common.NewStreamMeta(FAPIStreams["bookTicker"])
+	handler := newFuturesBookTickerHandler(logger.WithField("_caller", "FuturesBookTickerHandler"))
+
+	return &FuturesBookTickerStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesBookTickerStream")),
+	}
+}
+
+// NewFuturesAllBookTickerStream creates a stream for the all market book
+// ticker stream (!bookTicker). It has no path params, so the path function
+// is set right away.
+func NewFuturesAllBookTickerStream(wc common.WSClient, logger *log.Entry) *FuturesBookTickerStream {
+	sm := common.NewStreamMeta(FAPIStreams["allBookTicker"])
+	handler := newFuturesBookTickerHandler(logger.WithField("_caller", "FuturesAllBookTickerHandler"))
+
+	s := &FuturesBookTickerStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesAllBookTickerStream")),
+	}
+	s.SetPathFunc(s.path)
+	return s
+}
+
+func NewFuturesTickerStream(wc common.WSClient, logger *log.Entry) *FuturesTickerStream {
+	return newTickerStream[*FuturesTickerEvent](wc, FAPIStreams["ticker"], "ticker", "FuturesTicker", logger)
+}
+
+func NewFuturesMiniTickerStream(wc common.WSClient, logger *log.Entry) *FuturesMiniTickerStream {
+	return newTickerStream[*FuturesMiniTickerEvent](wc, FAPIStreams["miniTicker"], "miniTicker", "FuturesMiniTicker", logger)
+}
+
+func NewFuturesAllTickersStream(wc common.WSClient, logger *log.Entry) *FuturesAllTickersStream {
+	return newAllTickersStream[*FuturesTickerEvent](wc, FAPIStreams["allTickers"], "ticker", "FuturesAllTickers", logger)
+}
+
+func NewFuturesAllMiniTickersStream(wc common.WSClient, logger *log.Entry) *FuturesAllMiniTickersStream {
+	return newAllTickersStream[*FuturesMiniTickerEvent](wc, FAPIStreams["allMiniTickers"], "miniTicker", "FuturesAllMiniTickers", logger)
+}
+
+func NewFuturesPartialDepthStream(wc common.WSClient, logger *log.Entry) *FuturesPartialDepthStream {
+	sm := common.NewStreamMeta(FAPIStreams["partialDepth"])
+	handler := newFuturesPartialDepthHandler(logger.WithField("_caller", "FuturesPartialDepthHandler"))
+	streamLogger := logger.WithField("_caller", "FuturesPartialDepthStream")
+
+	return &FuturesPartialDepthStream{
+		Handler:      handler,
+		Stream:       wc.NewStream(sm, handler, streamLogger),
+		UpdateSpeed:  &sm.SD.UpdateSpeed,
+		endpointType: sm.SD.EndpointType,
+		logger:       streamLogger,
+	}
+}
+
+func NewFuturesMarkPriceStream(wc common.WSClient, logger *log.Entry) *FuturesMarkPriceStream {
+	sm := common.NewStreamMeta(FAPIStreams["markPrice"])
+	handler := newMarketStreamHandler[*FuturesMarkPriceEvent](logger.WithField("_caller", "FuturesMarkPriceHandler"))
+	streamLogger := logger.WithField("_caller", "FuturesMarkPriceStream")
+
+	return &FuturesMarkPriceStream{
+		Handler:     handler,
+		Stream:      wc.NewStream(sm, handler, streamLogger),
+		UpdateSpeed: &sm.SD.UpdateSpeed,
+		logger:      streamLogger,
+	}
+}
+
+// NewFuturesAllMarkPriceStream creates a stream for the all market mark
+// price stream. It has no path params, so the path function is set right away.
+func NewFuturesAllMarkPriceStream(wc common.WSClient, logger *log.Entry) *FuturesAllMarkPriceStream {
+	sm := common.NewStreamMeta(FAPIStreams["allMarkPrice"])
+	handler := newMarketArrayStreamHandler[*FuturesMarkPriceEvent](logger.WithField("_caller", "FuturesAllMarkPriceHandler"))
+	streamLogger := logger.WithField("_caller", "FuturesAllMarkPriceStream")
+
+	s := &FuturesAllMarkPriceStream{
+		Handler:     handler,
+		Stream:      wc.NewStream(sm, handler, streamLogger),
+		UpdateSpeed: &sm.SD.UpdateSpeed,
+		logger:      streamLogger,
+	}
+	s.SetPathFunc(s.path)
+	return s
+}
+
+func NewFuturesForceOrderStream(wc common.WSClient, logger *log.Entry) *FuturesForceOrderStream {
+	sm := common.NewStreamMeta(FAPIStreams["forceOrder"])
+	handler := newMarketStreamHandler[*FuturesForceOrderEvent](logger.WithField("_caller", "FuturesForceOrderHandler"))
+
+	return &FuturesForceOrderStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesForceOrderStream")),
+	}
+}
+
+// NewFuturesAllForceOrderStream creates a stream for the all market
+// liquidation order stream. It has no path params, so the path function is
+// set right away.
+func NewFuturesAllForceOrderStream(wc common.WSClient, logger *log.Entry) *FuturesForceOrderStream {
+	sm := common.NewStreamMeta(FAPIStreams["allForceOrder"])
+	handler := newMarketStreamHandler[*FuturesForceOrderEvent](logger.WithField("_caller", "FuturesAllForceOrderHandler"))
+
+	s := &FuturesForceOrderStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesAllForceOrderStream")),
+	}
+	s.SetPathFunc(s.path)
+	return s
+}
+
+func NewFuturesCompositeIndexStream(wc common.WSClient, logger *log.Entry) *FuturesCompositeIndexStream {
+	sm := common.NewStreamMeta(FAPIStreams["compositeIndex"])
+	handler := newMarketStreamHandler[*FuturesCompositeIndexEvent](logger.WithField("_caller", "FuturesCompositeIndexHandler"))
+
+	return &FuturesCompositeIndexStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesCompositeIndexStream")),
+	}
+}
+
+// NewFuturesContractInfoStream creates a stream for the contract info
+// stream. It has no path params, so the path function is set right away.
+func NewFuturesContractInfoStream(wc common.WSClient, logger *log.Entry) *FuturesContractInfoStream {
+	sm := common.NewStreamMeta(FAPIStreams["contractInfo"])
+	handler := newMarketStreamHandler[*FuturesContractInfoEvent](logger.WithField("_caller", "FuturesContractInfoHandler"))
+
+	s := &FuturesContractInfoStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesContractInfoStream")),
+	}
+	s.SetPathFunc(s.path)
+	return s
+}
+
+func NewFuturesUserDataStream(wc common.WSClient, logger *log.Entry) *FuturesUserDataStream {
+	sm := common.NewStreamMeta(FAPIStreams["userDataStream"])
+	handler := newFuturesUserDataStreamHandler(logger.WithField("_caller", "FuturesUserDataHandler"))
+	return &FuturesUserDataStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesUserDataStream")),
+	}
+}
+
+func NewFuturesDiffDepthCallbackStream(wc common.WSClient, logger *log.Entry) *FuturesDiffDepthCallbackStream {
+	return newCallbackDiffDepthStream[*FuturesDiffDepthEvent](wc, FAPIStreams["depth250ms"], "FuturesDiffDepthCallback", logger)
+}
+
+func NewFuturesDiffDepth100CallbackStream(wc common.WSClient, logger *log.Entry) *FuturesDiffDepthCallbackStream {
+	return newCallbackDiffDepthStream[*FuturesDiffDepthEvent](wc, FAPIStreams["depth100ms"], "FuturesDiffDepthCallback", logger)
+}
+
+func NewFuturesAggTradesCallbackStream(wc common.WSClient, logger *log.Entry) *FuturesAggTradesCallbackStream {
+	sm := common.NewStreamMeta(FAPIStreams["aggTrades"])
+	handler := newCallbackMarketStreamHandler[*FuturesAggTradesEvent](
+		logger.WithField("_caller", "FuturesAggTradesCallbackHandler"))
+
+	return &FuturesAggTradesCallbackStream{
+		Handler: handler,
+		Stream:  wc.NewStream(sm, handler, logger.WithField("_caller", "FuturesAggTradesCallbackStream")),
+	}
+}
@@ -43,10 +43,10 @@ func parseEventType(msg []byte, logger *log.Entry) (string, *common.WSHandlerErr
 	return event.EventType, nil
 }
 
-// unmarshalAndSendEvent unmarshals the message into the event and sends it to
-// the eventChan. If an error occurs, it is logged and returned to the caller.
-func unmarshalAndSendEvent[E Event](
-	msg []byte, event *E, TSLRecv, TSSRecv common.TSNano, eventChan chan<- E, logger *log.Entry,
+// unmarshalEvent unmarshals the message into the event and adds the event
+// meta. If an error occurs, it is logged and returned to the caller.
+func unmarshalEvent[E Event](
+	msg []byte, event *E, TSLRecv, TSSRecv common.TSNano, logger *log.Entry,
 ) *common.WSHandlerError {
 
 	// unmarshal event
@@ -57,11 +57,62 @@ func unmarshalAndSendEvent[E Event](
 
 	// add event meta
 	(*event).addEventMeta(TSLRecv, TSSRecv)
+	return nil
+}
+
+// unmarshalAndSendEvent unmarshals the message into the event and sends it to
+// the eventChan. If an error occurs, it is logged and returned to the caller.
+func unmarshalAndSendEvent[E Event](
+	msg []byte, event *E, TSLRecv, TSSRecv common.TSNano, eventChan chan<- E, logger *log.Entry,
+) *common.WSHandlerError {
+	if wshErr := unmarshalEvent(msg, event, TSLRecv, TSSRecv, logger); wshErr != nil {
+		return wshErr
+	}
 	eventChan <- (*event)
+	return nil
+}
 
+// unmarshalAndTrySendEvent is like unmarshalAndSendEvent, but it never blocks.
+// If the eventChan is full, the event is dropped and a warning is logged.
+// It is used for optional channels that the consumer may not read (e.g.
+// RawEventChan), and for events where a pending event already carries all
+// the information the receiver needs (e.g. listenKeyExpired).
+func unmarshalAndTrySendEvent[E Event](
+	msg []byte, event *E, TSLRecv, TSSRecv common.TSNano, eventChan chan<- E, logger *log.Entry,
+) *common.WSHandlerError {
+	if wshErr := unmarshalEvent(msg, event, TSLRecv, TSSRecv, logger); wshErr != nil {
+		return wshErr
+	}
+	select {
+	case eventChan <- (*event):
+	default:
+		logger.Warn("eventChan is full. dropping event")
+	}
+	return nil
+}
+
+// unmarshalAndCallEvent unmarshals the message into the event and calls
+// onEvent with it. onEvent may be nil, in which case the event is dropped.
+// If an error occurs, it is logged and returned to the caller.
+func unmarshalAndCallEvent[E Event](
+	msg []byte, event *E, TSLRecv, TSSRecv common.TSNano, onEvent func(E), logger *log.Entry,
+) *common.WSHandlerError {
+	if wshErr := unmarshalEvent(msg, event, TSLRecv, TSSRecv, logger); wshErr != nil {
+		return wshErr
+	}
+	if onEvent != nil {
+		onEvent(*event)
+	}
 	return nil
 }
 
+// newRawEvent wraps msg in a RawEvent, so that it can be passed to
+// unmarshalAndSendEvent or unmarshalAndCallEvent like new(*E).
+func newRawEvent(msg []byte) **RawEvent {
+	event := &RawEvent{Data: msg}
+	return &event
+}
+
 /* ==================== MarketStreamHandler ============================== */
 
 // newMarketStreamHandler creates a new MarketStreamHandler.
@@ -109,28 +160,97 @@ func (h *MarketStreamHandler[E]) HandleRecv(msg []byte, TSLRecv, TSSRecv common.
 	return wshErr
 }
 
+/* ==================== MarketArrayStreamHandler ========================= */
+
+// newMarketArrayStreamHandler creates a new MarketArrayStreamHandler.
+func newMarketArrayStreamHandler[E Event](logger *log.Entry) *MarketArrayStreamHandler[E] {
+	return &MarketArrayStreamHandler[E]{
+		EventChan: make(chan []E, 256),
+		ErrChan:   make(chan error, 1),
+		logger:    logger,
+	}
+}
+
+// MarketArrayStreamHandler implements the common.StreamHandler interface.
+// It is a generic handler for websocket market streams that send a JSON
+// array of events per message (e.g. !ticker@arr). Each message is sent to
+// the EventChan as one []E.
+type MarketArrayStreamHandler[E Event] struct {
+	EventChan chan []E
+	ErrChan   chan error
+	logger    *log.Entry
+}
+
+// HandleError puts the error on the ErrChan and expects the caller to handle
+// the error.
+func (h *MarketArrayStreamHandler[E]) HandleError(err error) {
+	h.ErrChan <- err
+}
+
+// HandleSend is not implemented. It is not used for market streams.
+func (h *MarketArrayStreamHandler[E]) HandleSend(req common.WSRequest) *common.WSHandlerError {
+	log.Warn(handleSendWarning)
+	return nil
+}
+
+// HandleRecv parses the message into a []E and sends it to the EventChan.
+// If an error occurs, it is logged and returned to the caller.
+func (h *MarketArrayStreamHandler[E]) HandleRecv(msg []byte, TSLRecv, TSSRecv common.TSNano) *common.WSHandlerError {
+	var events []E
+	if err := json.Unmarshal(msg, &events); err != nil {
+		h.logger.WithField("msg", string(msg)).WithError(err).Error("failed to unmarshal events")
+		return &common.WSHandlerError{Err: err, Reason: "failed to unmarshal events", IsFatal: true}
+	}
+
+	for _, event := range events {
+		event.addEventMeta(TSLRecv, TSSRecv)
+	}
+	h.EventChan <- events
+	return nil
+}
+
 /* ==================== SpotMarginUserDataStreamHandler ===================*/
 
 // newSpotMarginUserDataStreamHandler creates a new SpotMarginUserDataStreamHandler.
 func newSpotMarginUserDataStreamHandler[A, B, O Event](
 	logger *log.Entry) *SpotMarginUserDataStreamHandler[A, B, O] {
 	return &SpotMarginUserDataStreamHandler[A, B, O]{
-		AccountUpdateEventChan: make(chan A, 256),
-		BalanceUpdateEventChan: make(chan B, 256),
-		OrderUpdateEventChan:   make(chan O, 256),
-		ErrChan:                make(chan error, 1),
-		logger:                 logger,
+		AccountUpdateEventChan:           make(chan A, 256),
+		BalanceUpdateEventChan:           make(chan B, 256),
+		OrderUpdateEventChan:             make(chan O, 256),
+		ListStatusEventChan:              make(chan *ListStatusEvent, 256),
+		ListenKeyExpiredEventChan:        make(chan *ListenKeyExpiredEvent, 1),
+		MarginLevelStatusChangeEventChan: make(chan *MarginLevelStatusChangeEvent, 256),
+		LiabilityUpdateEventChan:         make(chan *LiabilityUpdateEvent, 256),
+		RawEventChan:                     make(chan *RawEvent, 256),
+		ErrChan:                          make(chan error, 1),
+		logger:                           logger,
 	}
 }
 
 // SpotMarginUserDataStreamHandler implements the common.StreamHandler
 // interface. It is a generic handler for spot/margin user data streams.
+// MarginLevelStatusChangeEventChan and LiabilityUpdateEventChan only
+// receive events on margin user data streams. Events with an unknown event
+// type are sent to the RawEventChan.
+// ListStatusEventChan, MarginLevelStatusChangeEventChan,
+// LiabilityUpdateEventChan and RawEventChan are optional: if one of them is
+// full, events are dropped (and a warning is logged) instead of blocking the
+// stream, so consumers don't have to drain channels they don't use.
+// ListenKeyExpiredEventChan has a buffer of 1. A pending event already tells
+// the receiver that the listen key has expired, so further listenKeyExpired
+// events are dropped while it is full, instead of blocking the stream.
 type SpotMarginUserDataStreamHandler[A, B, O Event] struct {
-	AccountUpdateEventChan chan A
-	BalanceUpdateEventChan chan B
-	OrderUpdateEventChan   chan O
-	ErrChan                chan error
-	logger                 *log.Entry
+	AccountUpdateEventChan           chan A
+	BalanceUpdateEventChan           chan B
+	OrderUpdateEventChan             chan O
+	ListStatusEventChan              chan *ListStatusEvent
+	ListenKeyExpiredEventChan        chan *ListenKeyExpiredEvent
+	MarginLevelStatusChangeEventChan chan *MarginLevelStatusChangeEvent
+	LiabilityUpdateEventChan         chan *LiabilityUpdateEvent
+	RawEventChan                     chan *RawEvent
+	ErrChan                          chan error
+	logger                           *log.Entry
 }
 
 // HandleError puts the error on the ErrChan and expects the caller to handle
@@ -165,9 +285,280 @@ func (h *SpotMarginUserDataStreamHandler[A, B, O]) HandleRecv(msg []byte, TSLRec
 		wshErr = unmarshalAndSendEvent(msg, new(B), TSLRecv, TSSRecv, h.BalanceUpdateEventChan, h.logger)
 	case "executionReport":
 		wshErr = unmarshalAndSendEvent(msg, new(O), TSLRecv, TSSRecv, h.OrderUpdateEventChan, h.logger)
+	case "listStatus":
+		wshErr = unmarshalAndTrySendEvent(msg, new(*ListStatusEvent), TSLRecv, TSSRecv, h.ListStatusEventChan, h.logger)
+	case "listenKeyExpired":
+		wshErr = unmarshalAndTrySendEvent(msg, new(*ListenKeyExpiredEvent), TSLRecv, TSSRecv, h.ListenKeyExpiredEventChan, h.logger)
+	case "MARGIN_LEVEL_STATUS_CHANGE":
+		wshErr = unmarshalAndTrySendEvent(msg, new(*MarginLevelStatusChangeEvent), TSLRecv, TSSRecv, h.MarginLevelStatusChangeEventChan, h.logger)
+	case "USER_LIABILITY_CHANGE":
+		wshErr = unmarshalAndTrySendEvent(msg, new(*LiabilityUpdateEvent), TSLRecv, TSSRecv, h.LiabilityUpdateEventChan, h.logger)
+	default:
+		h.logger.WithField("eventType", eventType).Debug("unknown event type")
+		wshErr = unmarshalAndTrySendEvent(msg, newRawEvent(msg), TSLRecv, TSSRecv, h.RawEventChan, h.logger)
+	}
+
+	return wshErr
+}
+
+/* ==================== FuturesUserDataStreamHandler ===================== */
+
+// newFuturesUserDataStreamHandler creates a new FuturesUserDataStreamHandler.
+func newFuturesUserDataStreamHandler(logger *log.Entry) *FuturesUserDataStreamHandler {
+	return &FuturesUserDataStreamHandler{
+		AccountUpdateEventChan:       make(chan *FuturesAccountUpdateEvent, 256),
+		OrderUpdateEventChan:         make(chan *FuturesOrderUpdateEvent, 256),
+		MarginCallEventChan:          make(chan *FuturesMarginCallEvent, 256),
+		AccountConfigUpdateEventChan: make(chan *FuturesAccountConfigUpdateEvent, 256),
+		TradeLiteEventChan:           make(chan *FuturesTradeLiteEvent, 256),
+		ListenKeyExpiredEventChan:    make(chan *FuturesListenKeyExpiredEvent, 1),
+		RawEventChan:                 make(chan *RawEvent, 256),
+		ErrChan:                      make(chan error, 1),
+		logger:                       logger,
+	}
+}
+
+// FuturesUserDataStreamHandler implements the common.StreamHandler
+// interface. It is the handler for futures user data streams. Events with
+// an unknown event type are sent to the RawEventChan. TradeLiteEventChan and
+// RawEventChan are optional, and listenKeyExpired events are dropped while
+// the ListenKeyExpiredEventChan is full (see SpotMarginUserDataStreamHandler).
+type FuturesUserDataStreamHandler struct {
+	AccountUpdateEventChan       chan *FuturesAccountUpdateEvent
+	OrderUpdateEventChan         chan *FuturesOrderUpdateEvent
+	MarginCallEventChan          chan *FuturesMarginCallEvent
+	AccountConfigUpdateEventChan chan *FuturesAccountConfigUpdateEvent
+	TradeLiteEventChan           chan *FuturesTradeLiteEvent
+	ListenKeyExpiredEventChan    chan *FuturesListenKeyExpiredEvent
+	RawEventChan                 chan *RawEvent
+	ErrChan                      chan error
+	logger                       *log.Entry
+}
+
+// HandleError puts the error on the ErrChan and expects the caller to handle
+// the error (see SpotMarginUserDataStreamHandler.HandleError).
+func (h *FuturesUserDataStreamHandler) HandleError(err error) {
+	h.ErrChan <- err
+}
+
+// HandleSend is not implemented. It is not used for futures user data streams.
+func (h *FuturesUserDataStreamHandler) HandleSend(req common.WSRequest) *common.WSHandlerError {
+	log.Warn(handleSendWarning)
+	return nil
+}
+
+// HandleRecv parses the message and sends it to the corresponding eventChan.
+// If an error occurs, it is logged and returned to the caller (see
+// SpotMarginUserDataStreamHandler.HandleRecv).
+func (h *FuturesUserDataStreamHandler) HandleRecv(msg []byte, TSLRecv, TSSRecv common.TSNano) *common.WSHandlerError {
+
+	// parse event type
+	eventType, wshErr := parseEventType(msg, h.logger)
+	if wshErr != nil {
+		return wshErr
+	}
+
+	switch eventType {
+	case "ACCOUNT_UPDATE":
+		wshErr = unmarshalAndSendEvent(msg, new(*FuturesAccountUpdateEvent), TSLRecv, TSSRecv, h.AccountUpdateEventChan, h.logger)
+	case "ORDER_TRADE_UPDATE":
+		wshErr = unmarshalAndSendEvent(msg, new(*FuturesOrderUpdateEvent), TSLRecv, TSSRecv, h.OrderUpdateEventChan, h.logger)
+	case "MARGIN_CALL":
+		wshErr = unmarshalAndSendEvent(msg, new(*FuturesMarginCallEvent), TSLRecv, TSSRecv, h.MarginCallEventChan, h.logger)
+	case "ACCOUNT_CONFIG_UPDATE":
+		wshErr = unmarshalAndSendEvent(msg, new(*FuturesAccountConfigUpdateEvent), TSLRecv, TSSRecv, h.AccountConfigUpdateEventChan, h.logger)
+	case "TRADE_LITE":
+		wshErr = unmarshalAndTrySendEvent(msg, new(*FuturesTradeLiteEvent), TSLRecv, TSSRecv, h.TradeLiteEventChan, h.logger)
+	case "listenKeyExpired":
+		wshErr = unmarshalAndTrySendEvent(msg, new(*FuturesListenKeyExpiredEvent), TSLRecv, TSSRecv, h.ListenKeyExpiredEventChan, h.logger)
+	default:
+		h.logger.WithField("eventType", eventType).Debug("unknown event type")
+		wshErr = unmarshalAndTrySendEvent(msg, newRawEvent(msg), TSLRecv, TSSRecv, h.RawEventChan, h.logger)
+	}
+
+	return wshErr
+}
+
+/* ==================== CallbackMarketStreamHandler ====================== */
+
+// newCallbackMarketStreamHandler creates a new CallbackMarketStreamHandler.
+func newCallbackMarketStreamHandler[E Event](logger *log.Entry) *CallbackMarketStreamHandler[E] {
+	return &CallbackMarketStreamHandler[E]{
+		logger: logger,
+	}
+}
+
+// CallbackMarketStreamHandler implements the common.StreamHandler interface.
+// It is the callback based alternative to MarketStreamHandler. Instead of
+// putting events and errors on channels, it calls onEvent and onError
+// synchronously on the goroutine that reads from the stream. Callbacks must
+// be registered before the stream is run, and should return quickly, since
+// they block the stream from reading the next message.
+type CallbackMarketStreamHandler[E Event] struct {
+	onEvent func(E)
+	onError func(error)
+	logger  *log.Entry
+}
+
+// OnEvent registers the callback that is called for every event.
+func (h *CallbackMarketStreamHandler[E]) OnEvent(f func(E)) *CallbackMarketStreamHandler[E] {
+	h.onEvent = f
+	return h
+}
+
+// OnError registers the callback that is called for every error.
+func (h *CallbackMarketStreamHandler[E]) OnError(f func(error)) *CallbackMarketStreamHandler[E] {
+	h.onError = f
+	return h
+}
+
+// HandleError calls onError with the error. stream.Run is the default caller
+// and will pass either a common.WSConnError or a common.WSHandlerError.
+func (h *CallbackMarketStreamHandler[E]) HandleError(err error) {
+	if h.onError != nil {
+		h.onError(err)
+	}
+}
+
+// HandleSend is not implemented. It is not used for market streams.
+func (h *CallbackMarketStreamHandler[E]) HandleSend(req common.WSRequest) *common.WSHandlerError {
+	log.Warn(handleSendWarning)
+	return nil
+}
+
+// HandleRecv parses the message and calls onEvent with it.
+// If an error occurs, it is logged and returned to the caller (see
+// MarketStreamHandler.HandleRecv).
+func (h *CallbackMarketStreamHandler[E]) HandleRecv(msg []byte, TSLRecv, TSSRecv common.TSNano) *common.WSHandlerError {
+	return unmarshalAndCallEvent(msg, new(E), TSLRecv, TSSRecv, h.onEvent, h.logger)
+}
+
+/* ==================== CallbackSpotMarginUserDataStreamHandler ========== */
+
+// newCallbackSpotMarginUserDataStreamHandler creates a new
+// CallbackSpotMarginUserDataStreamHandler.
+func newCallbackSpotMarginUserDataStreamHandler[A, B, O Event](
+	logger *log.Entry) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	return &CallbackSpotMarginUserDataStreamHandler[A, B, O]{
+		logger: logger,
+	}
+}
+
+// CallbackSpotMarginUserDataStreamHandler implements the common.StreamHandler
+// interface. It is the callback based alternative to
+// SpotMarginUserDataStreamHandler. All callbacks are called synchronously on
+// the goroutine that reads from the stream.
+type CallbackSpotMarginUserDataStreamHandler[A, B, O Event] struct {
+	onAccountUpdate           func(A)
+	onBalanceUpdate           func(B)
+	onOrderUpdate             func(O)
+	onListStatus              func(*ListStatusEvent)
+	onListenKeyExpired        func(*ListenKeyExpiredEvent)
+	onMarginLevelStatusChange func(*MarginLevelStatusChangeEvent)
+	onLiabilityUpdate         func(*LiabilityUpdateEvent)
+	onRawEvent                func(*RawEvent)
+	onError                   func(error)
+	logger                    *log.Entry
+}
+
+// OnAccountUpdate registers the callback for outboundAccountPosition events.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnAccountUpdate(f func(A)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onAccountUpdate = f
+	return h
+}
+
+// OnBalanceUpdate registers the callback for balanceUpdate events.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnBalanceUpdate(f func(B)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onBalanceUpdate = f
+	return h
+}
+
+// OnOrderUpdate registers the callback for executionReport events.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnOrderUpdate(f func(O)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onOrderUpdate = f
+	return h
+}
+
+// OnListStatus registers the callback for listStatus events.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnListStatus(f func(*ListStatusEvent)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onListStatus = f
+	return h
+}
+
+// OnListenKeyExpired registers the callback for listenKeyExpired events.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnListenKeyExpired(f func(*ListenKeyExpiredEvent)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onListenKeyExpired = f
+	return h
+}
+
+// OnMarginLevelStatusChange registers the callback for
+// MARGIN_LEVEL_STATUS_CHANGE events (MARGIN only).
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnMarginLevelStatusChange(f func(*MarginLevelStatusChangeEvent)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onMarginLevelStatusChange = f
+	return h
+}
+
+// OnLiabilityUpdate registers the callback for USER_LIABILITY_CHANGE events
+// (MARGIN only).
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnLiabilityUpdate(f func(*LiabilityUpdateEvent)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onLiabilityUpdate = f
+	return h
+}
+
+// OnRawEvent registers the callback for events with an unknown event type.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnRawEvent(f func(*RawEvent)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onRawEvent = f
+	return h
+}
+
+// OnError registers the callback that is called for every error.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) OnError(f func(error)) *CallbackSpotMarginUserDataStreamHandler[A, B, O] {
+	h.onError = f
+	return h
+}
+
+// HandleError calls onError with the error.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) HandleError(err error) {
+	if h.onError != nil {
+		h.onError(err)
+	}
+}
+
+// HandleSend is not implemented. It is not used spot/margin user data streams.
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) HandleSend(req common.WSRequest) *common.WSHandlerError {
+	log.Warn(handleSendWarning)
+	return nil
+}
+
+// HandleRecv parses the message and calls the corresponding callback.
+// If an error occurs, it is logged and returned to the caller (see
+// SpotMarginUserDataStreamHandler.HandleRecv).
+func (h *CallbackSpotMarginUserDataStreamHandler[A, B, O]) HandleRecv(msg []byte, TSLRecv, TSSRecv common.TSNano) *common.WSHandlerError {
+
+	// parse event type
+	eventType, wshErr := parseEventType(msg, h.logger)
+	if wshErr != nil {
+		return wshErr
+	}
+
+	switch eventType {
+	case "outboundAccountPosition":
+		wshErr = unmarshalAndCallEvent(msg, new(A), TSLRecv, TSSRecv, h.onAccountUpdate, h.logger)
+	case "balanceUpdate":
+		wshErr = unmarshalAndCallEvent(msg, new(B), TSLRecv, TSSRecv, h.onBalanceUpdate, h.logger)
+	case "executionReport":
+		wshErr = unmarshalAndCallEvent(msg, new(O), TSLRecv, TSSRecv, h.onOrderUpdate, h.logger)
+	case "listStatus":
+		wshErr = unmarshalAndCallEvent(msg, new(*ListStatusEvent), TSLRecv, TSSRecv, h.onListStatus, h.logger)
+	case "listenKeyExpired":
+		wshErr = unmarshalAndCallEvent(msg, new(*ListenKeyExpiredEvent), TSLRecv, TSSRecv, h.onListenKeyExpired, h.logger)
+	case "MARGIN_LEVEL_STATUS_CHANGE":
+		wshErr = unmarshalAndCallEvent(msg, new(*MarginLevelStatusChangeEvent), TSLRecv, TSSRecv, h.onMarginLevelStatusChange, h.logger)
+	case "USER_LIABILITY_CHANGE":
+		wshErr = unmarshalAndCallEvent(msg, new(*LiabilityUpdateEvent), TSLRecv, TSSRecv, h.onLiabilityUpdate, h.logger)
 	default:
-		err := fmt.Errorf("unknown event type: %s", eventType)
-		return &common.WSHandlerError{Err: err, Reason: "unknown event type", IsFatal: true}
+		h.logger.WithField("eventType", eventType).Debug("unknown event type")
+		wshErr = unmarshalAndCallEvent(msg, newRawEvent(msg), TSLRecv, TSSRecv, h.onRawEvent, h.logger)
 	}
 
 	return wshErr
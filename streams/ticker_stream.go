@@ -0,0 +1,225 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Shared TickerStream ============================== */
+
+// TickerStream is a shared Stream implementation for individual symbol
+// ticker and mini ticker streams. It includes a Stream, a handler for market
+// streams, and a symbol for WebSocket communication.
+type TickerStream[E Event] struct {
+	common.Stream
+	Handler    *MarketStreamHandler[E]
+	WSSymbol   *string
+	streamName string // ticker, miniTicker
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (in this case wsSymbol is the only param).
+func (s *TickerStream[E]) SetSymbol(restSymbol string) *TickerStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// path returns the path for the ticker stream. It is used as the path
+// function for the stream.
+func (s *TickerStream[E]) path() string {
+	path := "/ws/%s@%s"
+	return fmt.Sprintf(path, *s.WSSymbol, s.streamName)
+}
+
+// AllTickersStream is a shared Stream implementation for the all market
+// ticker and mini ticker streams (!ticker@arr, !miniTicker@arr). Every
+// message is an array of events, so it uses a MarketArrayStreamHandler.
+// It has no path params.
+type AllTickersStream[E Event] struct {
+	common.Stream
+	Handler    *MarketArrayStreamHandler[E]
+	streamName string // ticker, miniTicker
+}
+
+// path returns the path for the all market ticker stream. It is used as the
+// path function for the stream.
+func (s *AllTickersStream[E]) path() string {
+	path := "/ws/!%s@arr"
+	return fmt.Sprintf(path, s.streamName)
+}
+
+/* ==================== sharedTickerEvent ================================ */
+
+// sharedMiniTickerEvent is a shared event for mini ticker streams.
+type sharedMiniTickerEvent struct {
+	Symbol      string `json:"s"`
+	LastPrice   string `json:"c"`
+	OpenPrice   string `json:"o"`
+	HighPrice   string `json:"h"`
+	LowPrice    string `json:"l"`
+	Volume      string `json:"v"`
+	QuoteVolume string `json:"q"`
+}
+
+// sharedTickerEvent is a shared event for ticker streams.
+type sharedTickerEvent struct {
+	sharedMiniTickerEvent
+	PriceChange        string        `json:"p"`
+	PriceChangePercent string        `json:"P"`
+	WeightedAvgPrice   string        `json:"w"`
+	TSSOpen            common.TSNano `json:"O"`
+	TSSClose           common.TSNano `json:"C"`
+	FirstTradeID       int64         `json:"F"`
+	LastTradeID        int64         `json:"L"`
+	NumberOfTrades     int64         `json:"n"`
+}
+
+/* ==================== SpotMargin ======================================= */
+
+// SpotMarginTickerEvent is a 24hr ticker event for spot/margin streams.
+type SpotMarginTickerEvent struct {
+	StreamBaseEvent
+	sharedTickerEvent
+	FirstTradePrice string `json:"x"` // last price before the window
+	LastQty         string `json:"Q"`
+	BidPrice        string `json:"b"`
+	BidQty          string `json:"B"`
+	AskPrice        string `json:"a"`
+	AskQty          string `json:"A"`
+}
+
+// SpotMarginMiniTickerEvent is a 24hr mini ticker event for spot/margin streams.
+type SpotMarginMiniTickerEvent struct {
+	StreamBaseEvent
+	sharedMiniTickerEvent
+}
+
+// SpotMarginRollingWindowTickerEvent is a rolling window ticker event for
+// spot/margin streams (e.g. event type "1hTicker").
+type SpotMarginRollingWindowTickerEvent struct {
+	StreamBaseEvent
+	sharedTickerEvent
+}
+
+// SpotMarginTickerStream is a stream for spot/margin ticker streams.
+type SpotMarginTickerStream = TickerStream[*SpotMarginTickerEvent]
+
+// SpotMarginMiniTickerStream is a stream for spot/margin mini ticker streams.
+type SpotMarginMiniTickerStream = TickerStream[*SpotMarginMiniTickerEvent]
+
+// SpotMarginAllTickersStream is a stream for the spot/margin all market
+// ticker stream.
+type SpotMarginAllTickersStream = AllTickersStream[*SpotMarginTickerEvent]
+
+// SpotMarginAllMiniTickersStream is a stream for the spot/margin all market
+// mini ticker stream.
+type SpotMarginAllMiniTickersStream = AllTickersStream[*SpotMarginMiniTickerEvent]
+
+// SpotMarginRollingWindowTickerStream is a stream for spot/margin rolling
+// window ticker streams (<symbol>@ticker_<window>).
+type SpotMarginRollingWindowTickerStream struct {
+	common.Stream
+	Handler    *MarketStreamHandler[*SpotMarginRollingWindowTickerEvent]
+	WSSymbol   *string
+	WindowSize *common.BITickerWindowSize
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (wsSymbol and windowSize).
+func (s *SpotMarginRollingWindowTickerStream) SetSymbol(restSymbol string) *SpotMarginRollingWindowTickerStream {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.setPathFuncIfReady()
+	return s
+}
+
+// SetWindowSize sets the window size that is used in generating the path
+// for the stream. It also sets the path function for the stream, if all
+// path params are set (wsSymbol and windowSize).
+func (s *SpotMarginRollingWindowTickerStream) SetWindowSize(windowSize common.BITickerWindowSize) *SpotMarginRollingWindowTickerStream {
+	s.WindowSize = &windowSize
+	s.setPathFuncIfReady()
+	return s
+}
+
+// setPathFuncIfReady sets the path function once all path params are set.
+func (s *SpotMarginRollingWindowTickerStream) setPathFuncIfReady() {
+	if s.WSSymbol != nil && s.WindowSize != nil {
+		s.SetPathFunc(s.path)
+	}
+}
+
+// path returns the path for the rolling window ticker stream. It is used as
+// the path function for the stream.
+func (s *SpotMarginRollingWindowTickerStream) path() string {
+	path := "/ws/%s@ticker_%s"
+	return fmt.Sprintf(path, *s.WSSymbol, *s.WindowSize)
+}
+
+/* ==================== Futures ========================================== */
+
+// FuturesTickerEvent is a 24hr ticker event for futures streams.
+type FuturesTickerEvent struct {
+	StreamBaseEvent
+	sharedTickerEvent
+	LastQty string `json:"Q"`
+}
+
+// FuturesMiniTickerEvent is a 24hr mini ticker event for futures streams.
+type FuturesMiniTickerEvent struct {
+	StreamBaseEvent
+	sharedMiniTickerEvent
+}
+
+// FuturesTickerStream is a stream for futures ticker streams.
+type FuturesTickerStream = TickerStream[*FuturesTickerEvent]
+
+// FuturesMiniTickerStream is a stream for futures mini ticker streams.
+type FuturesMiniTickerStream = TickerStream[*FuturesMiniTickerEvent]
+
+// FuturesAllTickersStream is a stream for the futures all market ticker stream.
+type FuturesAllTickersStream = AllTickersStream[*FuturesTickerEvent]
+
+// FuturesAllMiniTickersStream is a stream for the futures all market mini
+// ticker stream.
+type FuturesAllMiniTickersStream = AllTickersStream[*FuturesMiniTickerEvent]
+
+/* ==================== Factory utils ==================================== */
+
+// newTickerStream creates a new TickerStream.
+func newTickerStream[E Event](
+	wc common.WSClient, sd common.StreamDefinition, streamName, caller string, logger *log.Entry,
+) *TickerStream[E] {
+	sm := common.NewStreamMeta(sd)
+	handler := newMarketStreamHandler[E](logger.WithField("_caller", caller+"Handler"))
+
+	return &TickerStream[E]{
+		Handler:    handler,
+		Stream:     wc.NewStream(sm, handler, logger.WithField("_caller", caller+"Stream")),
+		streamName: streamName,
+	}
+}
+
+// newAllTickersStream creates a new AllTickersStream, and sets the path
+// function right away.
+func newAllTickersStream[E Event](
+	wc common.WSClient, sd common.StreamDefinition, streamName, caller string, logger *log.Entry,
+) *AllTickersStream[E] {
+	sm := common.NewStreamMeta(sd)
+	handler := newMarketArrayStreamHandler[E](logger.WithField("_caller", caller+"Handler"))
+
+	s := &AllTickersStream[E]{
+		Handler:    handler,
+		Stream:     wc.NewStream(sm, handler, logger.WithField("_caller", caller+"Stream")),
+		streamName: streamName,
+	}
+	s.SetPathFunc(s.path)
+	return s
+}
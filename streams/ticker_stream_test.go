@@ -0,0 +1,118 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiTickerMsg = []byte(`{
+  "e": "24hrTicker",
+  "E": 1672515782136,
+  "s": "BNBBTC",
+  "p": "0.0015",
+  "P": "250.00",
+  "w": "0.0018",
+  "x": "0.0009",
+  "c": "0.0025",
+  "Q": "10",
+  "b": "0.0024",
+  "B": "10",
+  "a": "0.0026",
+  "A": "100",
+  "o": "0.0010",
+  "h": "0.0025",
+  "l": "0.0010",
+  "v": "10000",
+  "q": "18",
+  "O": 0,
+  "C": 86400000,
+  "F": 0,
+  "L": 18150,
+  "n": 18151
+}`)
+
+	apiTickerTarget = &SpotMarginTickerEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "24hrTicker", TSSEvent: common.NewTSNano(1672515782136)},
+		sharedTickerEvent: sharedTickerEvent{
+			sharedMiniTickerEvent: sharedMiniTickerEvent{
+				Symbol:      "BNBBTC",
+				LastPrice:   "0.0025",
+				OpenPrice:   "0.0010",
+				HighPrice:   "0.0025",
+				LowPrice:    "0.0010",
+				Volume:      "10000",
+				QuoteVolume: "18",
+			},
+			PriceChange:        "0.0015",
+			PriceChangePercent: "250.00",
+			WeightedAvgPrice:   "0.0018",
+			TSSOpen:            common.NewTSNano(0),
+			TSSClose:           common.NewTSNano(86400000),
+			FirstTradeID:       0,
+			LastTradeID:        18150,
+			NumberOfTrades:     18151,
+		},
+		FirstTradePrice: "0.0009",
+		LastQty:         "10",
+		BidPrice:        "0.0024",
+		BidQty:          "10",
+		AskPrice:        "0.0026",
+		AskQty:          "100",
+	}
+
+	fapiAllMiniTickersMsg = []byte(`[
+  {"e": "24hrMiniTicker", "E": 123456789, "s": "BTCUSDT", "c": "0.0025", "o": "0.0010", "h": "0.0025", "l": "0.0010", "v": "10000", "q": "18"},
+  {"e": "24hrMiniTicker", "E": 123456789, "s": "ETHUSDT", "c": "0.0030", "o": "0.0020", "h": "0.0031", "l": "0.0019", "v": "500", "q": "1.2"}
+]`)
+
+	fapiAllMiniTickersTarget = []*FuturesMiniTickerEvent{
+		{
+			StreamBaseEvent: StreamBaseEvent{EventType: "24hrMiniTicker", TSSEvent: common.NewTSNano(123456789)},
+			sharedMiniTickerEvent: sharedMiniTickerEvent{
+				Symbol: "BTCUSDT", LastPrice: "0.0025", OpenPrice: "0.0010", HighPrice: "0.0025",
+				LowPrice: "0.0010", Volume: "10000", QuoteVolume: "18",
+			},
+		},
+		{
+			StreamBaseEvent: StreamBaseEvent{EventType: "24hrMiniTicker", TSSEvent: common.NewTSNano(123456789)},
+			sharedMiniTickerEvent: sharedMiniTickerEvent{
+				Symbol: "ETHUSDT", LastPrice: "0.0030", OpenPrice: "0.0020", HighPrice: "0.0031",
+				LowPrice: "0.0019", Volume: "500", QuoteVolume: "1.2",
+			},
+		},
+	}
+)
+
+func TestUnmarshalTickerEvent(t *testing.T) {
+	apiEvent := &SpotMarginTickerEvent{}
+	err := json.Unmarshal(apiTickerMsg, apiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, apiTickerTarget, apiEvent)
+}
+
+func TestMarketArrayStreamHandler(t *testing.T) {
+	handler := newMarketArrayStreamHandler[*FuturesMiniTickerEvent](nil)
+	assert.NotNil(t, handler)
+
+	wshErr := handler.HandleRecv(fapiAllMiniTickersMsg, 0, 0)
+	assert.Nil(t, wshErr)
+	events := <-handler.EventChan
+	assert.Equal(t, fapiAllMiniTickersTarget, events)
+}
+
+func TestTickerPaths(t *testing.T) {
+	symbol := "bnbbtc"
+	s := &SpotMarginMiniTickerStream{WSSymbol: &symbol, streamName: "miniTicker"}
+	assert.Equal(t, "/ws/bnbbtc@miniTicker", s.path())
+
+	as := &FuturesAllTickersStream{streamName: "ticker"}
+	assert.Equal(t, "/ws/!ticker@arr", as.path())
+
+	windowSize := common.TickerWindowSize4h
+	rs := &SpotMarginRollingWindowTickerStream{WSSymbol: &symbol, WindowSize: &windowSize}
+	assert.Equal(t, "/ws/bnbbtc@ticker_4h", rs.path())
+}
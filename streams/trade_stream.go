@@ -0,0 +1,95 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+/* ==================== Shared TradeStream =============================== */
+
+// TradeStream is a shared Stream implementation for raw trade streams.
+// It includes a Stream, a handler for market streams, and a symbol for
+// WebSocket communication.
+type TradeStream[E Event] struct {
+	common.Stream
+	Handler  *MarketStreamHandler[E]
+	WSSymbol *string
+}
+
+// SetSymbol sets the wsSymbol that is used in generating the path for the
+// stream. It also sets the path function for the stream, if all path params
+// are set (in this case wsSymbol is the only param).
+func (s *TradeStream[E]) SetSymbol(restSymbol string) *TradeStream[E] {
+	wsSymbol := strings.ToLower(restSymbol)
+	s.WSSymbol = &wsSymbol
+	s.SetPathFunc(s.path)
+	return s
+}
+
+// path returns the path for the trade stream. It is used as the path
+// function for the stream.
+func (s *TradeStream[E]) path() string {
+	path := "/ws/%s@trade"
+	return fmt.Sprintf(path, *s.WSSymbol)
+}
+
+/* ==================== sharedTradeEvent ================================= */
+
+// sharedTradeEvent is a shared event for raw trade streams.
+type sharedTradeEvent struct {
+	Symbol       string        `json:"s"`
+	TradeID      int64         `json:"t"`
+	Price        string        `json:"p"`
+	Quantity     string        `json:"q"`
+	TSSTrade     common.TSNano `json:"T"`
+	IsBuyerMaker bool          `json:"m"`
+}
+
+/* ==================== SpotMargin ======================================= */
+
+// SpotMarginTradeEvent is a trade event for spot/margin streams.
+// NOTE: binance has stopped populating the buyer and seller order ids on
+// some spot endpoints. They are 0 if they are not sent.
+type SpotMarginTradeEvent struct {
+	StreamBaseEvent
+	sharedTradeEvent
+	BuyerOrderID  int64       `json:"b"`
+	SellerOrderID int64       `json:"a"`
+	Ignore        interface{} `json:"M"`
+}
+
+// SpotMarginTradeHandler is a handler for spot/margin trade streams.
+type SpotMarginTradeHandler = MarketStreamHandler[*SpotMarginTradeEvent]
+
+// newSpotMarginTradeHandler creates a new SpotMarginTradeHandler.
+func newSpotMarginTradeHandler(logger *log.Entry) *SpotMarginTradeHandler {
+	return newMarketStreamHandler[*SpotMarginTradeEvent](logger)
+}
+
+// SpotMarginTradeStream is a stream for spot/margin trade streams.
+type SpotMarginTradeStream = TradeStream[*SpotMarginTradeEvent]
+
+/* ==================== Futures ========================================== */
+
+// FuturesTradeEvent is a trade event for futures streams.
+type FuturesTradeEvent struct {
+	StreamBaseEvent
+	sharedTradeEvent
+	BuyerOrderID  int64  `json:"b"`
+	SellerOrderID int64  `json:"a"`
+	OrderType     string `json:"X"` // MARKET, ADL, INSURANCE_FUND, NA
+}
+
+// FuturesTradeHandler is a handler for futures trade streams.
+type FuturesTradeHandler = MarketStreamHandler[*FuturesTradeEvent]
+
+// newFuturesTradeHandler creates a new FuturesTradeHandler.
+func newFuturesTradeHandler(logger *log.Entry) *FuturesTradeHandler {
+	return newMarketStreamHandler[*FuturesTradeEvent](logger)
+}
+
+// FuturesTradeStream is a stream for futures trade streams.
+type FuturesTradeStream = TradeStream[*FuturesTradeEvent]
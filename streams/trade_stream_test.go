@@ -0,0 +1,91 @@
+package streams
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/svdro/shrimpy-binance/common"
+)
+
+var (
+	apiTradeMsg = []byte(`{
+  "e": "trade",
+  "E": 123456789,
+  "s": "BNBBTC",
+  "t": 12345,
+  "p": "0.001",
+  "q": "100",
+  "b": 88,
+  "a": 50,
+  "T": 123456785,
+  "m": true,
+  "M": true
+}`)
+
+	apiTradeTarget = &SpotMarginTradeEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "trade", TSSEvent: common.NewTSNano(123456789)},
+		sharedTradeEvent: sharedTradeEvent{
+			Symbol:       "BNBBTC",
+			TradeID:      12345,
+			Price:        "0.001",
+			Quantity:     "100",
+			TSSTrade:     common.NewTSNano(123456785),
+			IsBuyerMaker: true,
+		},
+		BuyerOrderID:  88,
+		SellerOrderID: 50,
+		Ignore:        true,
+	}
+
+	fapiTradeMsg = []byte(`{
+  "e": "trade",
+  "E": 123456789,
+  "T": 123456788,
+  "s": "BTCUSDT",
+  "t": 4141414,
+  "p": "42000.10",
+  "q": "0.005",
+  "b": 7711,
+  "a": 7712,
+  "X": "MARKET",
+  "m": false
+}`)
+
+	fapiTradeTarget = &FuturesTradeEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "trade", TSSEvent: common.NewTSNano(123456789)},
+		sharedTradeEvent: sharedTradeEvent{
+			Symbol:       "BTCUSDT",
+			TradeID:      4141414,
+			Price:        "42000.10",
+			Quantity:     "0.005",
+			TSSTrade:     common.NewTSNano(123456788),
+			IsBuyerMaker: false,
+		},
+		BuyerOrderID:  7711,
+		SellerOrderID: 7712,
+		OrderType:     "MARKET",
+	}
+)
+
+func TestUnmarshalTradeEvent(t *testing.T) {
+	apiEvent := &SpotMarginTradeEvent{}
+	err := json.Unmarshal(apiTradeMsg, apiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, apiTradeTarget, apiEvent)
+
+	fapiEvent := &FuturesTradeEvent{}
+	err = json.Unmarshal(fapiTradeMsg, fapiEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, fapiTradeTarget, fapiEvent)
+}
+
+func TestTradeHandler(t *testing.T) {
+	handler := newSpotMarginTradeHandler(nil)
+	assert.NotNil(t, handler)
+
+	handler.HandleRecv(apiTradeMsg, 0, 0)
+	event := <-handler.EventChan
+	assert.IsType(t, &SpotMarginTradeEvent{}, event)
+	assert.Equal(t, apiTradeTarget, event)
+}
@@ -1,6 +1,7 @@
 package streams
 
 import (
+	"encoding/json"
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
@@ -22,8 +23,33 @@ func (s *SpotMarginUserDataStream[A, B, O]) SetListenKey(listenKey string) *Spot
 }
 
 func (s *SpotMarginUserDataStream[A, B, O]) path() string {
+	return UserDataPath(*s.ListenKey)
+}
+
+// CallbackSpotMarginUserDataStream is the callback based variant of
+// SpotMarginUserDataStream.
+type CallbackSpotMarginUserDataStream[A, B, O Event] struct {
+	common.Stream
+	Handler   *CallbackSpotMarginUserDataStreamHandler[A, B, O]
+	ListenKey *string
+}
+
+func (s *CallbackSpotMarginUserDataStream[A, B, O]) SetListenKey(listenKey string) *CallbackSpotMarginUserDataStream[A, B, O] {
+	s.ListenKey = &listenKey
+	s.SetPathFunc(s.path)
+	return s
+}
+
+func (s *CallbackSpotMarginUserDataStream[A, B, O]) path() string {
+	return UserDataPath(*s.ListenKey)
+}
+
+// UserDataPath returns the path for a user data stream. It is exported for
+// services that manage the listen key and set their own path function (see
+// defaults.UserDataStreamService).
+func UserDataPath(listenKey string) string {
 	path := "/ws/%s"
-	return fmt.Sprintf(path, *s.ListenKey)
+	return fmt.Sprintf(path, listenKey)
 }
 
 /* ==================== UserDataEvents =================================== */
@@ -92,6 +118,63 @@ type OrderUpdateEvent struct {
 	Ignore2 interface{} `json:"M"`
 }
 
+// ListStatusOrder is an order of a list status event.
+type ListStatusOrder struct {
+	Symbol        string `json:"s"`
+	OrderID       int64  `json:"i"`
+	ClientOrderID string `json:"c"`
+}
+
+// ListStatusEvent is sent together with the executionReport events of the
+// orders of an order list (e.g. OCO), whenever the list status changes.
+type ListStatusEvent struct {
+	StreamBaseEvent
+	Symbol            string                   `json:"s"`
+	OrderListID       int64                    `json:"g"`
+	ContingencyType   common.BIContingencyType `json:"c"`
+	ListStatusType    common.BIListStatusType  `json:"l"`
+	ListOrderStatus   common.BIListOrderStatus `json:"L"`
+	ListRejectReason  string                   `json:"r"`
+	ListClientOrderID string                   `json:"C"`
+	TSSTransact       common.TSNano            `json:"T"`
+	Orders            []ListStatusOrder        `json:"O"`
+}
+
+// ListenKeyExpiredEvent is sent when the listen key of a user data stream
+// expired. No more events are sent on the stream afterwards, and a new
+// listen key is needed.
+type ListenKeyExpiredEvent struct {
+	StreamBaseEvent
+	ListenKey string `json:"listenKey"`
+}
+
+// MarginLevelStatusChangeEvent is sent when the margin level status of a
+// margin account changes (MARGIN only).
+type MarginLevelStatusChangeEvent struct {
+	StreamBaseEvent
+	MarginLevel       string                     `json:"l"`
+	MarginLevelStatus common.BIMarginLevelStatus `json:"s"`
+}
+
+// LiabilityUpdateEvent is sent when the liability of a margin account
+// changes, e.g. after a borrow or repay (MARGIN only).
+type LiabilityUpdateEvent struct {
+	StreamBaseEvent
+	Asset         string `json:"a"`
+	Type          string `json:"t"` // e.g. BORROW
+	TransactionID int64  `json:"T"`
+	Principal     string `json:"p"`
+	Interest      string `json:"i"`
+}
+
+// RawEvent is a user data event with an event type that is not known to the
+// handler. Data holds the raw message, so that new event types can be
+// handled by the caller before they are supported.
+type RawEvent struct {
+	StreamBaseEvent
+	Data json.RawMessage `json:"-"`
+}
+
 /* ==================== Spot ============================================= */
 
 // SpotAccountUpdateEvent is an account update event for spot markets.
@@ -118,6 +201,14 @@ func newSpotUserDataStreamHandler(logger *log.Entry) *SpotUserDataStreamHandler
 type SpotUserDataStream = SpotMarginUserDataStream[
 	*SpotAccountUpdateEvent, *SpotBalanceUpdateEvent, *SpotOrderUpdateEvent]
 
+// SpotUserDataCallbackHandler is a callback handler for spot user data streams.
+type SpotUserDataCallbackHandler = CallbackSpotMarginUserDataStreamHandler[
+	*SpotAccountUpdateEvent, *SpotBalanceUpdateEvent, *SpotOrderUpdateEvent]
+
+// SpotUserDataCallbackStream is a callback user data stream for spot markets.
+type SpotUserDataCallbackStream = CallbackSpotMarginUserDataStream[
+	*SpotAccountUpdateEvent, *SpotBalanceUpdateEvent, *SpotOrderUpdateEvent]
+
 /* ==================== Margin =========================================== */
 
 // MarginAccountUpdateEvent is an account update event for margin markets.
@@ -143,3 +234,11 @@ func newMarginUserDataStreamHandler(logger *log.Entry) *MarginUserDataStreamHand
 // MarginUserDataStream is a user data stream for margin markets.
 type MarginUserDataStream = SpotMarginUserDataStream[
 	*MarginAccountUpdateEvent, *MarginBalanceUpdateEvent, *MarginOrderUpdateEvent]
+
+// MarginUserDataCallbackHandler is a callback handler for margin user data streams.
+type MarginUserDataCallbackHandler = CallbackSpotMarginUserDataStreamHandler[
+	*MarginAccountUpdateEvent, *MarginBalanceUpdateEvent, *MarginOrderUpdateEvent]
+
+// MarginUserDataCallbackStream is a callback user data stream for margin markets.
+type MarginUserDataCallbackStream = CallbackSpotMarginUserDataStream[
+	*MarginAccountUpdateEvent, *MarginBalanceUpdateEvent, *MarginOrderUpdateEvent]
@@ -22,7 +22,7 @@ var (
 
 	accountUpdateTarget = &AccountUpdateEvent{
 		StreamBaseEvent: StreamBaseEvent{EventType: "outboundAccountPosition", TSSEvent: common.NewTSNano(1564034571105)},
-		TSSLUpdate:      common.NewTSNano(1564034571073),
+		TSSLastUpdate:   common.NewTSNano(1564034571073),
 		Balances:        []Balance{{Asset: "ETH", Free: "10000.000000", Locked: "0.000000"}},
 	}
 
@@ -113,6 +113,73 @@ var (
 		Ignore1:                 float64(8641984), // does not really matter
 		Ignore2:                 false,
 	}
+
+	listStatusMsg = []byte(`{
+  "e": "listStatus",
+  "E": 1564035303637,
+  "s": "ETHBTC",
+  "g": 2,
+  "c": "OCO",
+  "l": "EXEC_STARTED",
+  "L": "EXECUTING",
+  "r": "NONE",
+  "C": "F4QN4G8DlFATFlIUQ0cjdD",
+  "T": 1564035303625,
+  "O": [
+    {"s": "ETHBTC", "i": 17, "c": "AJYsMjErWJesZvqlJCTUgL"},
+    {"s": "ETHBTC", "i": 18, "c": "bfYPSQdLoqAJeNrOr9adzq"}
+  ]
+}`)
+
+	listStatusTarget = &ListStatusEvent{
+		StreamBaseEvent:   StreamBaseEvent{EventType: "listStatus", TSSEvent: common.NewTSNano(1564035303637)},
+		Symbol:            "ETHBTC",
+		OrderListID:       2,
+		ContingencyType:   common.ContingencyTypeOCO,
+		ListStatusType:    common.ListStatusTypeExecStarted,
+		ListOrderStatus:   common.ListOrderStatusExecuting,
+		ListRejectReason:  "NONE",
+		ListClientOrderID: "F4QN4G8DlFATFlIUQ0cjdD",
+		TSSTransact:       common.NewTSNano(1564035303625),
+		Orders: []ListStatusOrder{
+			{Symbol: "ETHBTC", OrderID: 17, ClientOrderID: "AJYsMjErWJesZvqlJCTUgL"},
+			{Symbol: "ETHBTC", OrderID: 18, ClientOrderID: "bfYPSQdLoqAJeNrOr9adzq"},
+		},
+	}
+
+	listenKeyExpiredMsg = []byte(`{"e": "listenKeyExpired", "E": 1699596037418, "listenKey": "OfYGbUzi3PraNagEkdKuFwUHn48brFsItTdsuiIXrucEvD0rhRXZ7I6URWfE8YE8"}`)
+
+	listenKeyExpiredTarget = &ListenKeyExpiredEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "listenKeyExpired", TSSEvent: common.NewTSNano(1699596037418)},
+		ListenKey:       "OfYGbUzi3PraNagEkdKuFwUHn48brFsItTdsuiIXrucEvD0rhRXZ7I6URWfE8YE8",
+	}
+
+	marginLevelStatusChangeMsg = []byte(`{"e": "MARGIN_LEVEL_STATUS_CHANGE", "E": 1710230460373, "l": "1.04", "s": "MARGIN_CALL"}`)
+
+	marginLevelStatusChangeTarget = &MarginLevelStatusChangeEvent{
+		StreamBaseEvent:   StreamBaseEvent{EventType: "MARGIN_LEVEL_STATUS_CHANGE", TSSEvent: common.NewTSNano(1710230460373)},
+		MarginLevel:       "1.04",
+		MarginLevelStatus: common.MarginLevelStatusMarginCall,
+	}
+
+	liabilityUpdateMsg = []byte(`{
+  "e": "USER_LIABILITY_CHANGE",
+  "E": 1710230460373,
+  "a": "BTC",
+  "t": "BORROW",
+  "T": 1352286576452864727,
+  "p": "1.03453430",
+  "i": "0"
+}`)
+
+	liabilityUpdateTarget = &LiabilityUpdateEvent{
+		StreamBaseEvent: StreamBaseEvent{EventType: "USER_LIABILITY_CHANGE", TSSEvent: common.NewTSNano(1710230460373)},
+		Asset:           "BTC",
+		Type:            "BORROW",
+		TransactionID:   1352286576452864727,
+		Principal:       "1.03453430",
+		Interest:        "0",
+	}
 )
 
 func TestUnmarshalAccountUpdateEvent(t *testing.T) {
@@ -177,11 +244,33 @@ func TestSpotUserDataStreamHadler(t *testing.T) {
 	assert.IsType(t, &SpotOrderUpdateEvent{}, orderEvent)
 	assert.Equal(t, orderUpdateEventTarget, orderEvent)
 
-	// unknown event type error
+	// listStatusEvent
+	err = handler.HandleRecv(listStatusMsg, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, listStatusTarget, <-handler.ListStatusEventChan)
+
+	// listenKeyExpiredEvent, further events are dropped while one is pending
+	err = handler.HandleRecv(listenKeyExpiredMsg, 0, 0)
+	assert.Nil(t, err)
+	err = handler.HandleRecv(listenKeyExpiredMsg, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, listenKeyExpiredTarget, <-handler.ListenKeyExpiredEventChan)
+	assert.Len(t, handler.ListenKeyExpiredEventChan, 0)
+
+	// unknown event types are not fatal, and are sent to the RawEventChan
 	err = handler.HandleRecv(unexpectedMsg, 0, 0)
-	assert.NotNil(t, err)
-	assert.IsType(t, &common.WSHandlerError{}, err)
-	assert.Equal(t, "unknown event type: unexpected", err.Err.Error())
+	assert.Nil(t, err)
+	rawEvent := <-handler.RawEventChan
+	assert.Equal(t, "unexpected", rawEvent.EventType)
+	assert.Equal(t, common.NewTSNano(123), rawEvent.TSSEvent)
+	assert.Equal(t, unexpectedMsg, []byte(rawEvent.Data))
+
+	// if the RawEventChan is not drained, unknown events are dropped instead
+	// of blocking the stream
+	for i := 0; i < cap(handler.RawEventChan)+1; i++ {
+		assert.Nil(t, handler.HandleRecv(unexpectedMsg, 0, 0))
+	}
+	assert.Len(t, handler.RawEventChan, cap(handler.RawEventChan))
 
 	// missing event type error
 	err = handler.HandleRecv(missingEventTypeMsg, 0, 0)
@@ -189,3 +278,58 @@ func TestSpotUserDataStreamHadler(t *testing.T) {
 	assert.IsType(t, &common.WSHandlerError{}, err)
 	assert.Equal(t, "event type is empty", err.Err.Error())
 }
+
+func TestMarginUserDataStreamHandler(t *testing.T) {
+	handler := newMarginUserDataStreamHandler(log.NewEntry(log.New()))
+	assert.NotNil(t, handler)
+
+	err := handler.HandleRecv(marginLevelStatusChangeMsg, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, marginLevelStatusChangeTarget, <-handler.MarginLevelStatusChangeEventChan)
+
+	err = handler.HandleRecv(liabilityUpdateMsg, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, liabilityUpdateTarget, <-handler.LiabilityUpdateEventChan)
+}
+
+func TestSpotUserDataCallbackStreamHandler(t *testing.T) {
+	handler := newCallbackSpotMarginUserDataStreamHandler[
+		*SpotAccountUpdateEvent, *SpotBalanceUpdateEvent, *SpotOrderUpdateEvent,
+	](log.NewEntry(log.New()))
+	assert.NotNil(t, handler)
+
+	var accountEvent *SpotAccountUpdateEvent
+	var balanceEvent *SpotBalanceUpdateEvent
+	var orderEvent *SpotOrderUpdateEvent
+	var listStatusEvent *ListStatusEvent
+	var rawEvent *RawEvent
+	handler.
+		OnAccountUpdate(func(e *SpotAccountUpdateEvent) { accountEvent = e }).
+		OnBalanceUpdate(func(e *SpotBalanceUpdateEvent) { balanceEvent = e }).
+		OnOrderUpdate(func(e *SpotOrderUpdateEvent) { orderEvent = e }).
+		OnListStatus(func(e *ListStatusEvent) { listStatusEvent = e }).
+		OnRawEvent(func(e *RawEvent) { rawEvent = e })
+
+	assert.Nil(t, handler.HandleRecv(accountUpdateMsg, 0, 0))
+	assert.Equal(t, accountUpdateTarget, accountEvent)
+
+	assert.Nil(t, handler.HandleRecv(balanceUpdateMsg, 0, 0))
+	assert.Equal(t, balanceUpdateTarget, balanceEvent)
+
+	assert.Nil(t, handler.HandleRecv(orderUpdateMsg, 0, 0))
+	assert.Equal(t, orderUpdateEventTarget, orderEvent)
+
+	assert.Nil(t, handler.HandleRecv(listStatusMsg, 0, 0))
+	assert.Equal(t, listStatusTarget, listStatusEvent)
+
+	assert.Nil(t, handler.HandleRecv(unexpectedMsg, 0, 0))
+	assert.Equal(t, "unexpected", rawEvent.EventType)
+
+	// events without a registered callback are dropped
+	assert.Nil(t, handler.HandleRecv(listenKeyExpiredMsg, 0, 0))
+
+	// missing event type error
+	err := handler.HandleRecv(missingEventTypeMsg, 0, 0)
+	assert.NotNil(t, err)
+	assert.Equal(t, "event type is empty", err.Err.Error())
+}
@@ -4,6 +4,16 @@ import (
 	"github.com/svdro/shrimpy-binance/common"
 )
 
+// containsInt returns true if ints contains i.
+func containsInt(ints []int, i int) bool {
+	for _, v := range ints {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
 // Event is an interface for all websocket events.
 // All events must implement addEventMeta.
 type Event interface {